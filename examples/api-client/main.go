@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"os"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -29,6 +30,8 @@ type model struct {
 	responseBody    string
 	responseTab     int // 0: Body, 1: Headers, 2: Status
 	responseViewer  *tui.Viewer
+	sending         bool
+	spinner         *tui.Spinner
 
 	// History
 	history        []HistoryItem
@@ -53,6 +56,9 @@ type model struct {
 	headerCountBadge *tui.BadgeElement
 }
 
+// responseMsg arrives when the mock request finishes
+type responseMsg struct{}
+
 type Header struct {
 	Key   string
 	Value string
@@ -130,8 +136,10 @@ func initialModel() model {
 	responseTabs := tui.NewTabsElement([]string{"Body", "Headers", "Status"})
 	responseTabs.SetActiveTab(0)
 	responseContainer.AddBorderElement(responseTabs, tui.BorderTop, tui.BorderAlignCenter)
-	// Add status indicator
-	responseContainer.AddBorderElement(tui.NewStatusElement("Ready"), tui.BorderTop, tui.BorderAlignRight)
+	// Add status indicator; the spinner animates while a request is in flight
+	spinner := tui.NewSpinner()
+	spinner.SetLabel("Ready")
+	responseContainer.AddBorderElement(tui.NewComponentElement(spinner, 0), tui.BorderTop, tui.BorderAlignRight)
 
 	return model{
 		width:          80,
@@ -145,6 +153,7 @@ func initialModel() model {
 		responseTab:    0,
 		responseTabs:   responseTabs,
 		responseViewer: responseViewer,
+		spinner:        spinner,
 		history: []HistoryItem{
 			{Method: "GET", URL: "https://api.github.com/users/github", Time: "10:23:45"},
 			{Method: "POST", URL: "https://api.example.com/users", Time: "10:15:32"},
@@ -304,35 +313,14 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		case "ctrl+r", "R":
 			// Send request (mock for now)
-			m.responseStatus = "200 OK"
-			m.responseBody = `{
-  "login": "github",
-  "id": 9919,
-  "avatar_url": "https://avatars.githubusercontent.com/u/9919?v=4",
-  "type": "Organization",
-  "name": "GitHub",
-  "blog": "https://github.com/about",
-  "location": "San Francisco, CA",
-  "email": null,
-  "twitter_username": "github",
-  "is_verified": true,
-  "has_organization_projects": true,
-  "has_repository_projects": true,
-  "public_repos": 2000,
-  "public_gists": 1248,
-  "followers": 0,
-  "following": 0,
-  "html_url": "https://github.com/github",
-  "created_at": "2008-01-22T23:15:41Z",
-  "updated_at": "2024-01-01T00:00:00Z"
-}`
-			m.responseHeaders = []Header{
-				{Key: "Content-Type", Value: "application/json; charset=utf-8"},
-				{Key: "X-RateLimit-Limit", Value: "60"},
-				{Key: "X-RateLimit-Remaining", Value: "59"},
+			if m.sending {
+				break
 			}
-			// Update the viewer with the response
-			m.responseViewer.SetContent(m.responseBody)
+			m.sending = true
+			m.spinner.SetLabel("Sending request…")
+			return m, tea.Batch(m.spinner.Start(), tea.Tick(900*time.Millisecond, func(time.Time) tea.Msg {
+				return responseMsg{}
+			}))
 		case "up":
 			if m.focus == "history" && m.historyIndex > 0 {
 				m.historyIndex--
@@ -389,6 +377,43 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
+	case responseMsg:
+		m.sending = false
+		m.spinner.Stop()
+		m.spinner.SetLabel("Ready")
+		m.responseStatus = "200 OK"
+		m.responseBody = `{
+  "login": "github",
+  "id": 9919,
+  "avatar_url": "https://avatars.githubusercontent.com/u/9919?v=4",
+  "type": "Organization",
+  "name": "GitHub",
+  "blog": "https://github.com/about",
+  "location": "San Francisco, CA",
+  "email": null,
+  "twitter_username": "github",
+  "is_verified": true,
+  "has_organization_projects": true,
+  "has_repository_projects": true,
+  "public_repos": 2000,
+  "public_gists": 1248,
+  "followers": 0,
+  "following": 0,
+  "html_url": "https://github.com/github",
+  "created_at": "2008-01-22T23:15:41Z",
+  "updated_at": "2024-01-01T00:00:00Z"
+}`
+		m.responseHeaders = []Header{
+			{Key: "Content-Type", Value: "application/json; charset=utf-8"},
+			{Key: "X-RateLimit-Limit", Value: "60"},
+			{Key: "X-RateLimit-Remaining", Value: "59"},
+		}
+		// Update the viewer with the response
+		m.responseViewer.SetContent(m.responseBody)
+
+	case tui.TimerMsg:
+		return m, tui.HandleTimers(msg, m.spinner)
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -10,8 +10,6 @@ import (
 	"github.com/johnnyfreeman/tint/tui"
 )
 
-type tickMsg time.Time
-
 type model struct {
 	screen          *tui.Screen
 	sidebar         *Sidebar
@@ -19,7 +17,8 @@ type model struct {
 	modal           *tui.Modal
 	modalContainer  *tui.Container
 	modalContent    *tui.TextArea
-	notification    *tui.Notification
+	notifications   *tui.NotificationCenter
+	palette         *tui.CommandPalette
 	themePicker     *ThemePicker
 	statusBar       *tui.StatusBar
 	width           int
@@ -31,7 +30,7 @@ func initialModel() model {
 	// Set up status bar
 	statusBar := tui.NewStatusBar()
 	statusBar.AddSegment("Tab: focus", "left")
-	statusBar.AddSegment("Ctrl+B: sidebar | 1-3: tabs | m: modal | n/s/w/e: notif | t: theme | q: quit", "right")
+	statusBar.AddSegment("Ctrl+K: commands | Ctrl+B: sidebar | 1-3: tabs | m: modal | n/s/w/e: notif | t: theme | q: quit", "right")
 
 	// Create modal with container and content (Modal → Container → Content pattern)
 	modal, modalContainer, modalContent := createDemoModal()
@@ -43,7 +42,8 @@ func initialModel() model {
 		modal:          modal,
 		modalContainer: modalContainer,
 		modalContent:   modalContent,
-		notification:   tui.NewNotification(),
+		notifications:  tui.NewNotificationCenter(),
+		palette:        createDemoPalette(),
 		themePicker:    NewThemePicker(),
 		statusBar:      statusBar,
 		width:          80,
@@ -53,21 +53,17 @@ func initialModel() model {
 }
 
 func (m model) Init() tea.Cmd {
-	return tea.Batch(
-		tea.EnterAltScreen,
-		tickCmd(),
-	)
-}
-
-func tickCmd() tea.Cmd {
-	return tea.Tick(time.Second/60, func(t time.Time) tea.Msg {
-		return tickMsg(t)
-	})
+	return tea.EnterAltScreen
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// The command palette takes every key while it's open
+		if m.palette.IsVisible() {
+			return m, m.palette.Update(msg)
+		}
+
 		// Handle theme picker controls when focused
 		if m.themePicker.IsVisible() && m.focus == "themepicker" {
 			switch msg.String() {
@@ -89,7 +85,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				newTheme := m.themePicker.GetSelectedTheme()
 				if oldTheme != newTheme {
 					themeName := tui.GetTheme(newTheme).Name
-					m.notification.ShowSuccess("Theme changed to " + themeName)
+					m.notifications.ShowSuccess("Theme changed to " + themeName)
 				}
 				// Return focus after selecting
 				if m.sidebar.IsVisible() {
@@ -98,7 +94,7 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.focus = "tabs"
 				}
 			}
-			return m, nil
+			return m, m.notifications.TimerCmd()
 		}
 
 		// Handle modal controls when focused
@@ -117,6 +113,11 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Notification action keys take precedence
+		if m.notifications.HandleKey(msg.String()) {
+			return m, m.notifications.TimerCmd()
+		}
+
 		switch msg.String() {
 		case "q", "ctrl+c":
 			return m, tea.Quit
@@ -129,6 +130,8 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.focus = "sidebar"
 				}
 			}
+		case "ctrl+k":
+			m.palette.Show()
 		case "ctrl+b":
 			m.sidebar.Toggle()
 			if m.sidebar.IsVisible() {
@@ -146,13 +149,18 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.modalContainer.Blur()
 			}
 		case "n":
-			m.notification.ShowInfo("This is a sample notification!")
+			m.notifications.ShowInfo("This is a sample notification!")
 		case "s":
-			m.notification.ShowSuccess("Operation completed successfully!")
+			m.notifications.ShowSuccess("Operation completed successfully!")
 		case "w":
-			m.notification.ShowWarning("Warning: Check your settings")
+			m.notifications.ShowWarning("Warning: Check your settings")
 		case "e":
-			m.notification.ShowError("Error: Something went wrong")
+			m.notifications.Push(tui.Toast{
+				Message:  "Error: Something went wrong",
+				Type:     tui.NotificationError,
+				Duration: 5 * time.Second,
+				Actions:  []tui.NotificationAction{{Key: "x", Label: "dismiss"}},
+			})
 		case "t":
 			m.themePicker.Toggle()
 			if m.themePicker.IsVisible() {
@@ -186,12 +194,52 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		theme := tui.GetTheme(m.themePicker.GetPreviewTheme())
 		m.screen = tui.NewScreen(m.width, m.height, theme)
 
-	case tickMsg:
-		m.notification.Update()
-		return m, tickCmd()
+	case tui.CommandMsg:
+		return m, m.runCommand(msg.Command.ID)
+
+	case tui.TimerMsg:
+		return m, m.notifications.HandleTimer(msg)
 	}
 
-	return m, nil
+	// Wake up exactly when the notifications next change
+	return m, m.notifications.TimerCmd()
+}
+
+// runCommand runs a command chosen from the command palette
+func (m *model) runCommand(id string) tea.Cmd {
+	switch id {
+	case "quit":
+		return tea.Quit
+	case "sidebar":
+		m.sidebar.Toggle()
+		if m.sidebar.IsVisible() {
+			m.focus = "sidebar"
+		} else {
+			m.focus = "tabs"
+		}
+	case "theme":
+		if !m.themePicker.IsVisible() {
+			m.themePicker.Toggle()
+		}
+		m.focus = "themepicker"
+	case "modal":
+		if !m.modal.IsVisible() {
+			m.modal.Toggle()
+		}
+		m.focus = "modal"
+		m.modal.Focus()
+		m.modalContainer.Focus()
+	case "tab-1", "tab-2", "tab-3":
+		m.tabs.SetActive(int(id[len(id)-1] - '1'))
+		m.focus = "tabs"
+	case "info":
+		m.notifications.ShowInfo("This is a sample notification!")
+	case "success":
+		m.notifications.ShowSuccess("Operation completed successfully!")
+	case "warning":
+		m.notifications.ShowWarning("Warning: Check your settings")
+	}
+	return m.notifications.TimerCmd()
 }
 
 func (m model) View() string {
@@ -264,12 +312,15 @@ func (m model) View() string {
 		m.modalContainer.Draw(m.screen, modalX, modalY, modalWidth, modalHeight, &theme)
 	}
 
-	// Draw notification (always on top)
-	m.notification.Draw(m.screen, 0, 0, &theme)
+	// Draw notifications (always on top, above the status bar)
+	m.notifications.Draw(m.screen, 0, 0, m.width, m.height-1, &theme)
 
 	// Draw theme picker (always on top)
 	m.themePicker.DrawWithTheme(m.screen, &theme, m.focus == "themepicker")
 
+	// Draw command palette above everything else
+	m.palette.Draw(m.screen, 0, 0, m.width, m.height, &theme)
+
 	// Draw status bar at the bottom
 	m.statusBar.Draw(m.screen, 0, m.height-1, m.width, 1, &theme)
 
@@ -277,6 +328,23 @@ func (m model) View() string {
 	return m.screen.Render()
 }
 
+func createDemoPalette() *tui.CommandPalette {
+	palette := tui.NewCommandPalette()
+	palette.SetCommands([]tui.Command{
+		{ID: "sidebar", Title: "Toggle Sidebar", Group: "View", Keys: "ctrl+b"},
+		{ID: "theme", Title: "Choose Theme", Group: "View", Keys: "t"},
+		{ID: "modal", Title: "Open Sample Modal", Group: "View", Keys: "m"},
+		{ID: "tab-1", Title: "Go to Overview", Group: "Tabs", Keys: "1"},
+		{ID: "tab-2", Title: "Go to Themes", Group: "Tabs", Keys: "2"},
+		{ID: "tab-3", Title: "Go to Logs", Group: "Tabs", Keys: "3"},
+		{ID: "info", Title: "Show Info Notification", Group: "Notifications", Keys: "n"},
+		{ID: "success", Title: "Show Success Notification", Group: "Notifications", Keys: "s"},
+		{ID: "warning", Title: "Show Warning Notification", Group: "Notifications", Keys: "w"},
+		{ID: "quit", Title: "Quit", Group: "App", Keys: "q"},
+	})
+	return palette
+}
+
 func createDemoTabs() *tui.TabsComponent {
 	themeCount := len(tui.GetAvailableThemes())
 	tabs := tui.NewTabs()
@@ -6,12 +6,9 @@ import (
 
 type Sidebar struct {
 	container *tui.Container
-	viewer    *tui.Viewer
+	list      *tui.List
 	visible   bool
 	width     int
-	items     []string
-	selected  int
-	hovered   int // For keyboard navigation (not yet selected)
 }
 
 func NewSidebar() *Sidebar {
@@ -20,24 +17,24 @@ func NewSidebar() *Sidebar {
 	container.SetTitle("Sidebar")
 	container.SetSize(20, 0) // Height will be set dynamically
 	container.SetPadding(tui.NewMargin(1))
-	
-	// Create viewer for sidebar content
-	viewer := tui.NewViewer()
-	container.SetContent(viewer)
-	
+
+	// The selection follows the cursor, like a navigation menu
+	list := tui.NewList()
+	list.SetItems([]tui.ListItem{
+		{Title: "Dashboard"},
+		{Title: "Files"},
+		{Title: "Settings"},
+		{Title: "About"},
+	})
+	list.OnHover(list.Select)
+	list.Select(0)
+	container.SetContent(list)
+
 	return &Sidebar{
 		container: container,
-		viewer:    viewer,
+		list:      list,
 		visible:   true,
 		width:     20,
-		items: []string{
-			"Dashboard",
-			"Files",
-			"Settings",
-			"About",
-		},
-		selected: 0,
-		hovered:  0,
 	}
 }
 
@@ -50,29 +47,12 @@ func (s *Sidebar) DrawWithTheme(screen *tui.Screen, x, y, height int, theme tui.
 	s.container.SetSize(s.width, height)
 	if focused {
 		s.container.Focus()
+		s.list.Focus()
 	} else {
 		s.container.Blur()
+		s.list.Blur()
 	}
 
-	// Build sidebar content with styled items
-	content := ""
-	for i, item := range s.items {
-		var prefix string
-		if i == s.selected {
-			prefix = "▶ " // Solid right-pointing triangle
-		} else {
-			prefix = "  "
-		}
-		
-		if i > 0 {
-			content += "\n"
-		}
-		content += prefix + item
-	}
-	
-	// Update viewer content
-	s.viewer.SetContent(content)
-	
 	// Draw the container (which handles borders, focus, etc.)
 	s.container.Draw(screen, x, y, s.width, height, &theme)
 }
@@ -93,31 +73,9 @@ func (s *Sidebar) Width() int {
 }
 
 func (s *Sidebar) MoveUp() {
-	if s.selected > 0 {
-		s.selected--
-		s.hovered = s.selected
-	}
+	s.list.MoveUp()
 }
 
 func (s *Sidebar) MoveDown() {
-	if s.selected < len(s.items)-1 {
-		s.selected++
-		s.hovered = s.selected
-	}
-}
-
-func (s *Sidebar) HoverUp() {
-	if s.hovered > 0 {
-		s.hovered--
-	}
-}
-
-func (s *Sidebar) HoverDown() {
-	if s.hovered < len(s.items)-1 {
-		s.hovered++
-	}
-}
-
-func (s *Sidebar) Select() {
-	s.selected = s.hovered
+	s.list.MoveDown()
 }
@@ -8,9 +8,8 @@ import (
 type ThemePicker struct {
 	modal        *tui.Modal
 	container    *tui.Container
+	list         *tui.List
 	themes       []string
-	selected     int
-	hovered      int
 	width        int
 	height       int
 	previewTheme string // Theme being previewed on hover
@@ -18,19 +17,11 @@ type ThemePicker struct {
 
 func NewThemePicker() *ThemePicker {
 	themes := tui.GetAvailableThemes()
-	// Find monochrome index for default
-	monochromeIndex := 0
-	for i, theme := range themes {
-		if theme == "monochrome" {
-			monochromeIndex = i
-			break
-		}
-	}
-	
+
 	// Create modal (Modal → Container → Content pattern)
-	width := 45 // Wider to accommodate longer theme names
+	width := 45               // Wider to accommodate longer theme names
 	height := len(themes) + 4 // Dynamic height based on theme count
-	
+
 	modal := tui.NewModal()
 	modal.SetSize(width, height)
 	modal.SetCentered(true)
@@ -40,15 +31,59 @@ func NewThemePicker() *ThemePicker {
 	container.SetTitle("Choose Theme")
 	container.SetSize(width, height) // Fill the entire modal surface
 	container.SetPadding(tui.NewMargin(1))
-	
-	return &ThemePicker{
-		modal:        modal,
-		container:    container,
-		themes:       themes,
-		selected:     monochromeIndex,
-		hovered:      monochromeIndex,
-		width:        width,
-		height:       height,
+
+	tp := &ThemePicker{
+		modal:     modal,
+		container: container,
+		list:      tui.NewList(),
+		themes:    themes,
+		width:     width,
+		height:    height,
+	}
+
+	items := make([]tui.ListItem, len(themes))
+	for i, name := range themes {
+		items[i] = tui.ListItem{Title: tui.GetTheme(name).Name, Value: name}
+	}
+	tp.list.SetItems(items)
+	tp.list.SetRenderer(tui.ItemRendererFunc(drawThemeItem))
+	tp.list.OnHover(func(index int) {
+		tp.previewTheme = tp.themes[index]
+	})
+	container.SetContent(tp.list)
+
+	// Default to monochrome
+	for i, theme := range themes {
+		if theme == "monochrome" {
+			tp.list.Select(i)
+			break
+		}
+	}
+	return tp
+}
+
+// drawThemeItem draws a theme name with swatches of its main colors
+func drawThemeItem(screen *tui.Screen, item tui.ListItem, state tui.ListItemState, x, y, width int, currentTheme *tui.Theme) {
+	style := state.Style(currentTheme)
+	prefix := "○ " // Empty circle for hoverable
+	if state.Selected {
+		prefix = "◉ " // Filled circle for selected
+		style = style.Bold(true)
+	}
+	screen.DrawString(x, y, tui.FillRight(prefix+item.Title, width), style)
+
+	// Draw color swatches
+	theme := tui.GetTheme(item.Value.(string))
+	swatchX := x + width - 12
+	for i, color := range []lipgloss.TerminalColor{
+		theme.Palette.Love,
+		theme.Palette.Gold,
+		theme.Palette.Rose,
+		theme.Palette.Pine,
+		theme.Palette.Foam,
+		theme.Palette.Iris,
+	} {
+		screen.DrawString(swatchX+i*2, y, "●", style.Foreground(color))
 	}
 }
 
@@ -68,70 +103,22 @@ func (tp *ThemePicker) DrawWithTheme(screen *tui.Screen, currentTheme *tui.Theme
 	// Focus the container when theme picker is focused
 	if focused {
 		tp.container.Focus()
+		tp.list.Focus()
 	} else {
 		tp.container.Blur()
+		tp.list.Blur()
 	}
 
-	// Draw container filling the entire modal surface
+	// Draw container filling the entire modal surface; the list inside
+	// draws the theme options
 	tp.container.Draw(screen, modalX, modalY, modalWidth, modalHeight, currentTheme)
-
-	// Draw theme options with color swatches inside container
-	for i, themeName := range tp.themes {
-		theme := tui.GetTheme(themeName)
-		lineY := modalY + 2 + i // Position relative to modal
-
-		// Determine item state and style
-		itemX := modalX + 2 // Position relative to modal
-		prefix := "  "
-		var style lipgloss.Style
-
-		if i == tp.selected {
-			// Selected theme - highlight full row
-			for dx := modalX + 1; dx < modalX+tp.width-1; dx++ {
-				screen.SetCell(dx, lineY, tui.Cell{
-					Rune:       ' ',
-					Background: currentTheme.Palette.Primary,
-				})
-			}
-			colors := currentTheme.Components.Interactive.Selected
-			style = lipgloss.NewStyle().
-				Foreground(colors.Text).
-				Background(currentTheme.Palette.Primary).
-				Bold(true)
-			prefix = "◉ " // Filled circle for selected
-		} else if i == tp.hovered {
-			// Hovered theme
-			colors := currentTheme.Components.Interactive.Hover
-			style = lipgloss.NewStyle().
-				Foreground(colors.Text).
-				Background(currentTheme.Palette.Surface)
-			prefix = "○ " // Empty circle for hoverable
-		} else {
-			// Normal theme
-			colors := currentTheme.Components.Interactive.Normal
-			style = lipgloss.NewStyle().
-				Foreground(colors.Text).
-				Background(currentTheme.Palette.Surface)
-			prefix = "○ " // Empty circle for normal
-		}
-
-		screen.DrawString(itemX, lineY, prefix+theme.Name, style)
-
-		// Draw color swatches
-		swatchX := modalX + tp.width - 14
-		screen.DrawString(swatchX, lineY, "●", lipgloss.NewStyle().Foreground(theme.Palette.Love).Background(currentTheme.Palette.Surface))
-		screen.DrawString(swatchX+2, lineY, "●", lipgloss.NewStyle().Foreground(theme.Palette.Gold).Background(currentTheme.Palette.Surface))
-		screen.DrawString(swatchX+4, lineY, "●", lipgloss.NewStyle().Foreground(theme.Palette.Rose).Background(currentTheme.Palette.Surface))
-		screen.DrawString(swatchX+6, lineY, "●", lipgloss.NewStyle().Foreground(theme.Palette.Pine).Background(currentTheme.Palette.Surface))
-		screen.DrawString(swatchX+8, lineY, "●", lipgloss.NewStyle().Foreground(theme.Palette.Foam).Background(currentTheme.Palette.Surface))
-		screen.DrawString(swatchX+10, lineY, "●", lipgloss.NewStyle().Foreground(theme.Palette.Iris).Background(currentTheme.Palette.Surface))
-	}
 }
 
 func (tp *ThemePicker) Toggle() {
 	tp.modal.Toggle()
 	if tp.modal.IsVisible() {
-		tp.hovered = tp.selected
+		_, selected, _ := tp.list.SelectedItem()
+		tp.list.SetCursor(selected)
 		tp.modal.Focus()
 		tp.container.Focus()
 	} else {
@@ -145,26 +132,21 @@ func (tp *ThemePicker) IsVisible() bool {
 }
 
 func (tp *ThemePicker) MoveUp() {
-	if tp.hovered > 0 {
-		tp.hovered--
-		tp.previewTheme = tp.themes[tp.hovered]
-	}
+	tp.list.MoveUp()
 }
 
 func (tp *ThemePicker) MoveDown() {
-	if tp.hovered < len(tp.themes)-1 {
-		tp.hovered++
-		tp.previewTheme = tp.themes[tp.hovered]
-	}
+	tp.list.MoveDown()
 }
 
 func (tp *ThemePicker) Select() {
-	tp.selected = tp.hovered
+	tp.list.Select(tp.list.Cursor())
 	tp.modal.Hide()
 }
 
 func (tp *ThemePicker) GetSelectedTheme() string {
-	return tp.themes[tp.selected]
+	_, selected, _ := tp.list.SelectedItem()
+	return tp.themes[selected]
 }
 
 func (tp *ThemePicker) GetPreviewTheme() string {
@@ -63,9 +63,15 @@ type confirmModalComponent struct {
 type formModalComponent struct {
 	modal     *tui.Modal
 	container *tui.Container
-	inputs    []*tui.Input
-	labels    []string
-	activeIdx int
+	form      *tui.Form
+	user      userDetails
+}
+
+// userDetails is bound to the form modal
+type userDetails struct {
+	Name    string `form:"name"`
+	Email   string `form:"email"`
+	Message string `form:"message"`
 }
 
 func initialModel() *model {
@@ -173,23 +179,33 @@ Press Escape to close any modal.`)
 
 	nameInput := tui.NewInput()
 	nameInput.SetPlaceholder("Enter your name...")
-	nameInput.SetWidth(42)
 
 	emailInput := tui.NewInput()
 	emailInput.SetPlaceholder("Enter your email...")
-	emailInput.SetWidth(42)
 
 	messageInput := tui.NewInput()
 	messageInput.SetPlaceholder("Enter a message...")
-	messageInput.SetWidth(42)
+
+	form := tui.NewForm()
+	form.AddField("name", "Name", nameInput, tui.Required())
+	form.AddField("email", "Email", emailInput, tui.Required(), tui.Pattern(`^[^@\s]+@[^@\s]+$`, "must be an email address"))
+	form.AddField("message", "Message", messageInput, tui.MaxLength(140))
+	formContainer.SetContent(form)
 
 	formComp := &formModalComponent{
 		modal:     formModal,
 		container: formContainer,
-		inputs:    []*tui.Input{nameInput, emailInput, messageInput},
-		labels:    []string{"Name:", "Email:", "Message:"},
-		activeIdx: 0,
+		form:      form,
 	}
+	form.OnSubmit(func(map[string]any) {
+		form.Store(&formComp.user)
+		formComp.modal.Hide()
+		formComp.container.Blur()
+	})
+	form.OnCancel(func() {
+		formComp.modal.Hide()
+		formComp.container.Blur()
+	})
 
 	return &model{
 		screen:       tui.NewScreen(defaultWidth, defaultHeight, theme),
@@ -301,8 +317,9 @@ func (m *model) showModal(modalType string) {
 		m.formModal.modal.Show()
 		m.formModal.modal.Focus()
 		m.formModal.container.Focus()
-		m.formModal.activeIdx = 0
-		m.formModal.inputs[0].Focus()
+		m.formModal.form.Reset()
+		m.formModal.form.Load(m.formModal.user)
+		m.formModal.form.Focus()
 	}
 }
 
@@ -332,15 +349,9 @@ func (m *model) handleFuzzyInput(msg tea.KeyMsg) {
 func (m *model) updateFuzzyResults(query string) {
 	m.fuzzyModal.filtered = []string{}
 
-	if query == "" {
-		m.fuzzyModal.filtered = m.fuzzyModal.items
-	} else {
-		query = strings.ToLower(query)
-		for _, item := range m.fuzzyModal.items {
-			if strings.Contains(strings.ToLower(item), query) {
-				m.fuzzyModal.filtered = append(m.fuzzyModal.filtered, item)
-			}
-		}
+	// Best matches first; an empty query matches everything in order
+	for _, result := range tui.FuzzyFilter(query, m.fuzzyModal.items) {
+		m.fuzzyModal.filtered = append(m.fuzzyModal.filtered, m.fuzzyModal.items[result.Index])
 	}
 
 	m.fuzzyModal.selectedIdx = 0
@@ -363,32 +374,9 @@ func (m *model) handleConfirmInput(msg tea.KeyMsg) {
 }
 
 func (m *model) handleFormInput(msg tea.KeyMsg) {
-	switch msg.String() {
-	case "tab", "down":
-		m.formModal.inputs[m.formModal.activeIdx].Blur()
-		m.formModal.activeIdx = (m.formModal.activeIdx + 1) % len(m.formModal.inputs)
-		m.formModal.inputs[m.formModal.activeIdx].Focus()
-	case "shift+tab", "up":
-		m.formModal.inputs[m.formModal.activeIdx].Blur()
-		m.formModal.activeIdx--
-		if m.formModal.activeIdx < 0 {
-			m.formModal.activeIdx = len(m.formModal.inputs) - 1
-		}
-		m.formModal.inputs[m.formModal.activeIdx].Focus()
-	case "enter":
-		if m.formModal.activeIdx == len(m.formModal.inputs)-1 {
-			// Submit form
-			m.activeModal = ""
-			m.formModal.modal.Hide()
-			m.formModal.container.Blur()
-		} else {
-			// Move to next field
-			m.formModal.inputs[m.formModal.activeIdx].Blur()
-			m.formModal.activeIdx++
-			m.formModal.inputs[m.formModal.activeIdx].Focus()
-		}
-	default:
-		m.formModal.inputs[m.formModal.activeIdx].HandleInput(msg.String())
+	m.formModal.form.HandleKey(msg.String())
+	if !m.formModal.modal.IsVisible() {
+		m.activeModal = ""
 	}
 }
 
@@ -623,29 +611,11 @@ func (m *model) drawFormModal() {
 	// Draw container filling the entire modal surface
 	m.formModal.container.Draw(m.screen, modalX, modalY, modalWidth, modalHeight, &m.theme)
 
-	// Draw form fields inside container
-	labelStyle := lipgloss.NewStyle().
-		Foreground(m.theme.Palette.Text).
-		Background(m.theme.Palette.Surface)
-
-	fieldY := modalY + 3
-	fieldSpacing := 4
-
-	for i, label := range m.formModal.labels {
-		// Draw label
-		m.screen.DrawString(modalX+2, fieldY, label, labelStyle)
-
-		// Draw input
-		m.formModal.inputs[i].Draw(m.screen, modalX+2, fieldY+1, 42, 1, &m.theme)
-
-		fieldY += fieldSpacing
-	}
-
 	// Draw help text
 	helpStyle := lipgloss.NewStyle().
 		Foreground(m.theme.Palette.TextMuted).
 		Background(m.theme.Palette.Surface)
-	helpText := "Tab: Next field | Enter: Submit | Escape: Cancel"
+	helpText := "Tab: Next field | Ctrl+S: Submit | Escape: Cancel"
 	helpX := modalX + (modalWidth-len(helpText))/2
 	m.screen.DrawString(helpX, modalY+modalHeight-3, helpText, helpStyle)
 }
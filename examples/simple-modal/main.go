@@ -24,6 +24,18 @@ func initialModel() *model {
 	modal := tui.NewModal()
 	modal.SetSize(40, 12)
 	modal.SetCentered(true)
+	modal.SetDimBackground(true)
+
+	// Create a container that fills the modal
+	container := tui.NewContainer()
+	container.SetTitle("Hello Modal!")
+	container.SetPadding(tui.NewMargin(1))
+
+	// Add content
+	textarea := tui.NewTextArea()
+	textarea.SetValue("This is a simple modal example.\n\nThe modal provides:\n• Elevated surface\n• Drop shadow\n\nThe container provides:\n• Border and title\n• Padding")
+	container.SetContent(textarea)
+	modal.SetContent(container)
 
 	return &model{
 		screen:    tui.NewScreen(80, 24, theme),
@@ -109,26 +121,6 @@ func (m *model) View() string {
 	// Draw modal if visible
 	if m.showModal {
 		m.modal.Draw(m.screen, 0, 0, m.width, m.height, &m.theme)
-
-		// Get modal position for container
-		modalWidth, modalHeight := m.modal.GetSize()
-		modalX := (m.width - modalWidth) / 2
-		modalY := (m.height - modalHeight) / 2
-
-		// Create a container that fills the modal
-		container := tui.NewContainer()
-		container.SetTitle("Hello Modal!")
-		container.SetSize(modalWidth, modalHeight)
-		container.SetPadding(tui.NewMargin(1))
-
-		// Add content
-		textarea := tui.NewTextArea()
-		textarea.SetValue("This is a simple modal example.\n\nThe modal provides:\n• Elevated surface\n• Drop shadow\n\nThe container provides:\n• Border and title\n• Padding")
-		textarea.SetSize(modalWidth-4, modalHeight-4)
-		container.SetContent(textarea)
-
-		// Draw the container
-		container.Draw(m.screen, modalX, modalY, modalWidth, modalHeight, &m.theme)
 	}
 
 	// Show status
@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -21,7 +22,7 @@ const (
 	fuzzyFinderWidth  = 90
 	fuzzyFinderHeight = 30
 	settingsWidth     = 50
-	settingsHeight    = 13
+	settingsHeight    = 17
 
 	// UI constraints
 	maxFuzzyResults = 14
@@ -41,17 +42,13 @@ var (
 		"README.md", "CONTRIBUTING.md", "LICENSE",
 	}
 
-	explorerFiles = []string{
-		"src/",
-		"  main.go",
-		"  config.go",
-		"  utils.go",
-		"tests/",
-		"  main_test.go",
-		"docs/",
-		"  README.md",
-		"go.mod",
-		"go.sum",
+	// Explorer folders and the files in them; "" holds top-level files
+	explorerFolders = []string{"src", "tests", "docs"}
+	explorerFiles   = map[string][]string{
+		"src":   {"main.go", "config.go", "utils.go"},
+		"tests": {"main_test.go"},
+		"docs":  {"README.md"},
+		"":      {"go.mod", "go.sum"},
 	}
 
 	demoContent = map[string]string{
@@ -121,6 +118,16 @@ GLOBAL SHORTCUTS
   ?         Show/hide this help
   Tab       Switch between open files
   w         Close current tab
+  m         Open the tab menu
+
+MENUS
+=====
+  F10       Open the menu bar
+  Alt+F     Open the File menu (E, V, H for the others)
+  ←/→       Switch menus or open a submenu
+  ↑/↓       Move through items
+  Enter     Choose item
+  Escape    Close the menu
 
 FILE OPERATIONS
 ===============
@@ -161,7 +168,7 @@ SETTINGS
 ========
   ↑/↓       Navigate settings
   Space     Toggle setting
-  Enter     Toggle setting
+  ←/→       Switch setting off/on
   Escape    Close settings
 
 Tips:
@@ -195,7 +202,7 @@ type model struct {
 
 	// Components
 	editor       *tui.TextArea
-	fileExplorer *tui.Table
+	fileExplorer *tui.Tree
 	fuzzyFinder  *fuzzyFinderComponent
 	settings     *settingsComponent
 	helpViewer   *helpViewerComponent
@@ -203,23 +210,27 @@ type model struct {
 	notification *tui.Notification
 	statusBar    *tui.StatusBar
 
+	// Menus run the same actions as the shortcuts in keys
+	keys         *tui.Keymap
+	menuBar      *tui.MenuBar
+	tabMenu      *tui.ContextMenu
+	menuAction   string // Chosen from a menu, run once the key is handled
+	explorerItem *tui.MenuItem
+	themeName    string
+	activeTabX   int // Where the active tab starts, for the tab menu
+
+	// Overlays keep focus inside the open modal and restore it on close
+	overlays *tui.OverlayManager
+
 	// Demo data
 	openFiles    []string
 	activeTab    int
 	unsavedFiles map[string]bool
 
-	// Auto-save timer
-	lastTypedTimer int
+	// Auto-save fires after a pause in typing
+	autoSave *tui.Timer
 }
 
-type fuzzyFinderFocus int
-
-const (
-	FocusSearch fuzzyFinderFocus = iota
-	FocusResults
-	FocusPreview
-)
-
 type fuzzyFinderComponent struct {
 	*tui.Modal
 	searchContainer  *tui.Container
@@ -230,19 +241,12 @@ type fuzzyFinderComponent struct {
 	allFiles         []string
 	filtered         []string
 	selectedIdx      int
-	internalFocus    fuzzyFinderFocus
+	focusRoot        tui.Component // Tab order: search, results, preview
 }
 
 type settingsComponent struct {
 	*tui.Modal
-	options     []settingOption
-	selectedIdx int
-}
-
-type settingOption struct {
-	name  string
-	value string
-	kind  string // "toggle", "select", "input"
+	form *tui.Form
 }
 
 type helpViewerComponent struct {
@@ -250,6 +254,53 @@ type helpViewerComponent struct {
 	viewer *tui.Viewer
 }
 
+// newExplorerSource builds the demo file tree shown in the explorer
+func newExplorerSource() *tui.StaticTreeSource {
+	source := tui.NewStaticTreeSource()
+	for _, folder := range explorerFolders {
+		source.Add("", tui.TreeNode{ID: folder, Title: folder, Icon: "📁"})
+		for _, file := range explorerFiles[folder] {
+			source.Add(folder, tui.TreeNode{ID: folder + "/" + file, Title: file, Icon: "📄", Leaf: true})
+		}
+	}
+	for _, file := range explorerFiles[""] {
+		source.Add("", tui.TreeNode{ID: file, Title: file, Icon: "📄", Leaf: true})
+	}
+	return source
+}
+
+// newSettingsForm builds the settings screen's fields
+func newSettingsForm() *tui.Form {
+	form := tui.NewForm()
+	form.SetButtons("Done", "")
+	form.Keymap().SetKeys("next", "tab", "down")
+	form.Keymap().SetKeys("previous", "shift+tab", "up")
+
+	theme := tui.NewSelect(tui.GetAvailableThemes()...)
+	theme.SetValue("tokyonight")
+	fontSize := tui.NewInput()
+	fontSize.SetValue("14")
+	tabSize := tui.NewInput()
+	tabSize.SetValue("4")
+	isNumber := tui.Pattern(`^[0-9]+$`, "must be a number")
+
+	toggle := func(on bool) *tui.Toggle {
+		t := tui.NewToggle("")
+		t.SetOn(on)
+		return t
+	}
+
+	form.AddField("theme", "Theme", theme)
+	form.AddField("font-size", "Font Size", fontSize, tui.Required(), isNumber)
+	form.AddField("line-numbers", "Line Numbers", toggle(true))
+	form.AddField("word-wrap", "Word Wrap", toggle(false))
+	form.AddField("auto-save", "Auto Save", toggle(true))
+	form.AddField("tab-size", "Tab Size", tabSize, tui.Required(), isNumber)
+	form.AddField("minimap", "Minimap", toggle(true))
+	form.AddField("bracket-matching", "Bracket Matching", toggle(true))
+	return form
+}
+
 func initialModel() *model {
 	theme := tui.GetTheme("tokyonight")
 
@@ -271,22 +322,7 @@ func main() {
 	editor.Focus() // Make sure editor is focused initially
 
 	// Create file explorer
-	fileExplorer := tui.NewTable()
-	fileExplorer.SetColumns([]tui.TableColumn{
-		{Title: "📁 Files", Width: 25},
-	})
-	fileExplorer.SetRows([]tui.TableRow{
-		{"📁 src/"},
-		{"  📄 main.go"},
-		{"  📄 config.go"},
-		{"  📄 utils.go"},
-		{"📁 tests/"},
-		{"  📄 main_test.go"},
-		{"📁 docs/"},
-		{"  📄 README.md"},
-		{"📄 go.mod"},
-		{"📄 go.sum"},
-	})
+	fileExplorer := tui.NewTree(newExplorerSource())
 
 	// Create fuzzy finder modal (Modal → Container → Content pattern)
 	fuzzyModal := tui.NewModal()
@@ -330,9 +366,18 @@ func main() {
 		previewViewer:    previewViewer,
 		allFiles:         demoFiles,
 		filtered:         []string{},
-		internalFocus:    FocusSearch, // Start with search focused
 	}
 
+	// The containers are positioned by hand when drawing; this layout only
+	// tells the overlay manager which components take focus, in tab order
+	leftColumn := tui.VBox()
+	leftColumn.AddFixed(searchContainer, searchContainerHeight)
+	leftColumn.AddFlex(resultsContainer, 1)
+	fuzzyFocus := tui.HBox()
+	fuzzyFocus.AddFixed(leftColumn, resultsContainerWidth)
+	fuzzyFocus.AddFlex(previewContainer, 1)
+	fuzzyFinder.focusRoot = fuzzyFocus
+
 	// Create settings
 	settingsModal := tui.NewModal()
 	settingsModal.SetSize(settingsWidth, settingsHeight)
@@ -340,17 +385,12 @@ func main() {
 
 	settings := &settingsComponent{
 		Modal: settingsModal,
-		options: []settingOption{
-			{name: "Theme", value: "Tokyo Night", kind: "select"},
-			{name: "Font Size", value: "14", kind: "input"},
-			{name: "Line Numbers", value: "On", kind: "toggle"},
-			{name: "Word Wrap", value: "Off", kind: "toggle"},
-			{name: "Auto Save", value: "On", kind: "toggle"},
-			{name: "Tab Size", value: "4", kind: "input"},
-			{name: "Minimap", value: "On", kind: "toggle"},
-			{name: "Bracket Matching", value: "On", kind: "toggle"},
-		},
+		form:  newSettingsForm(),
 	}
+	settings.form.OnSubmit(func(map[string]any) {
+		settings.Hide()
+	})
+	settings.form.OnCancel(settings.Hide)
 
 	// Create tabs
 	tabs := tui.NewTabs()
@@ -386,7 +426,11 @@ func main() {
 	statusBar.AddSegment("Ln 5, Col 12", "center")
 	statusBar.AddSegment("UTF-8 | Spaces: 4", "right")
 
-	return &model{
+	// Focus returns to the editor when the last overlay closes
+	overlays := tui.NewOverlayManager()
+	overlays.SetBase(tui.NewFocusTree(editor))
+
+	m := &model{
 		screen:       tui.NewScreen(defaultWidth, defaultHeight, theme),
 		width:        defaultWidth,
 		height:       defaultHeight,
@@ -400,28 +444,117 @@ func main() {
 		tabs:         tabs,
 		notification: notification,
 		statusBar:    statusBar,
+		keys:         newEditorKeymap(),
+		themeName:    "tokyonight",
+		overlays:     overlays,
+		autoSave:     tui.NewTimer(nil),
 		openFiles:    []string{"main.go", "config.go", "README.md"},
 		activeTab:    0,
 		unsavedFiles: make(map[string]bool),
 	}
+
+	m.menuBar = m.newMenuBar()
+	m.tabMenu = m.newTabMenu()
+
+	// Enter on a file opens it; folders expand and collapse
+	fileExplorer.OnSelect(func(node tui.TreeNode) {
+		if node.Leaf {
+			m.openFile(node.Title)
+		} else {
+			fileExplorer.Toggle(node.ID)
+		}
+	})
+	return m
+}
+
+// newEditorKeymap returns the global shortcuts, which the menus show as hints
+func newEditorKeymap() *tui.Keymap {
+	return tui.NewKeymap("editor",
+		tui.KeyBinding{Action: "find-file", Keys: []string{"p"}, Help: "find file"},
+		tui.KeyBinding{Action: "toggle-explorer", Keys: []string{"e"}, Help: "explorer"},
+		tui.KeyBinding{Action: "settings", Keys: []string{"s"}, Help: "settings"},
+		tui.KeyBinding{Action: "help", Keys: []string{"?"}, Help: "help"},
+		tui.KeyBinding{Action: "next-tab", Keys: []string{"tab"}, Help: "next tab"},
+		tui.KeyBinding{Action: "close-tab", Keys: []string{"w"}, Help: "close tab"},
+		tui.KeyBinding{Action: "tab-menu", Keys: []string{"m"}, Help: "tab menu"},
+		tui.KeyBinding{Action: "quit", Keys: []string{"q"}, Help: "quit"},
+	)
+}
+
+// newMenuBar builds the File, Edit, View and Help menus
+func (m *model) newMenuBar() *tui.MenuBar {
+	file := tui.NewMenu("File")
+	file.AddItem("Find File…", "find-file")
+	file.AddItem("Close Tab", "close-tab")
+	file.AddSeparator()
+	file.AddItem("Quit", "quit")
+
+	// The demo doesn't really edit, so these stay disabled
+	edit := tui.NewMenu("Edit")
+	edit.Add(tui.MenuItem{Label: "Undo", Hint: "Ctrl+Z", Disabled: true})
+	edit.Add(tui.MenuItem{Label: "Redo", Hint: "Ctrl+Y", Disabled: true})
+	edit.AddSeparator()
+	edit.Add(tui.MenuItem{Label: "Cut", Hint: "Ctrl+X", Disabled: true})
+	edit.Add(tui.MenuItem{Label: "Copy", Hint: "Ctrl+C", Disabled: true})
+	edit.Add(tui.MenuItem{Label: "Paste", Hint: "Ctrl+V", Disabled: true})
+
+	names := tui.GetAvailableThemes()
+	sort.Strings(names)
+	themes := tui.NewMenu("Theme")
+	for _, name := range names {
+		item := themes.AddCheckItem(name, "", name == m.themeName)
+		item.OnSelect = func() {
+			m.themeName = name
+			m.theme = tui.GetTheme(name)
+			m.screen = tui.NewScreen(m.width, m.height, m.theme)
+			for _, other := range themes.Items() {
+				other.Checked = other.Label == name
+			}
+		}
+	}
+
+	view := tui.NewMenu("View")
+	m.explorerItem = view.AddCheckItem("File Explorer", "toggle-explorer", false)
+	view.AddItem("Next Tab", "next-tab")
+	view.AddSeparator()
+	view.AddSubmenu("Theme", themes)
+	view.AddItem("Settings…", "settings")
+
+	help := tui.NewMenu("Help")
+	help.AddItem("Keyboard Shortcuts", "help")
+
+	bar := tui.NewMenuBar(file, edit, view, help)
+	bar.SetAccelerators(m.keys)
+	bar.OnSelect(func(item *tui.MenuItem) {
+		m.menuAction = item.Action
+	})
+	return bar
+}
+
+// newTabMenu builds the context menu for the active tab
+func (m *model) newTabMenu() *tui.ContextMenu {
+	menu := tui.NewMenu("Tab")
+	menu.AddItem("Next Tab", "next-tab")
+	menu.AddItem("Close Tab", "close-tab")
+	menu.AddItem("Close Other Tabs", "close-other-tabs")
+
+	tabMenu := tui.NewContextMenu(menu)
+	tabMenu.SetAccelerators(m.keys)
+	tabMenu.OnSelect(func(item *tui.MenuItem) {
+		m.menuAction = item.Action
+	})
+	return tabMenu
 }
 
 func (m *model) Init() tea.Cmd {
 	return tea.Batch(
 		tea.SetWindowTitle("Tint Text Editor Demo"),
 		tea.WindowSize(), // Request initial window size
-		tickCmd(),        // Start the tick timer
 	)
 }
 
-// tickMsg is sent every second
-type tickMsg struct{}
-
-func tickCmd() tea.Cmd {
-	return tea.Tick(time.Second, func(time.Time) tea.Msg {
-		return tickMsg{}
-	})
-}
+// autoSaveDelay is how long after the last keystroke the file is auto-saved
+const autoSaveDelay = 5 * time.Second
 
 func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
@@ -430,23 +563,31 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.height = msg.Height
 		m.screen = tui.NewScreen(m.width, m.height, m.theme)
 
-	case tickMsg:
-		// Update notification (handles auto-hide)
-		m.notification.Update()
+	case tui.TimerMsg:
+		if m.autoSave.Fired(msg) {
+			m.notification.ShowSuccess("Auto-saved")
+			return m, m.notification.TimerCmd()
+		}
+		// Auto-hide the notification
+		return m, m.notification.HandleTimer(msg)
 
-		// Increment typing timer
-		if m.lastTypedTimer > 0 {
-			m.lastTypedTimer++
+	case tea.KeyMsg:
+		// A search in the explorer takes every key, esc included
+		if m.activeView == "explorer" && m.fileExplorer.Searching() {
+			m.fileExplorer.HandleKey(msg.String())
+			return m, nil
 		}
 
-		// Trigger auto-save after 5 seconds of no typing
-		if m.lastTypedTimer == 5 {
-			m.notification.ShowSuccess("Auto-saved")
-			m.lastTypedTimer = 0
+		// An open menu takes every key, esc included; f10 and alt with a
+		// letter open the menu bar
+		if m.activeView == "editor" || m.activeView == "explorer" {
+			if m.tabMenu.HandleKey(msg.String()) || m.menuBar.HandleKey(msg.String()) {
+				action := m.menuAction
+				m.menuAction = ""
+				return m, m.runAction(action)
+			}
 		}
-		return m, tickCmd()
 
-	case tea.KeyMsg:
 		// Handle escape key first, before routing to components
 		if msg.String() == "escape" || msg.String() == "esc" || msg.Type == tea.KeyEsc {
 			return m.handleEscape(), nil
@@ -469,72 +610,19 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		// Global shortcuts
-		switch msg.String() {
-		case "q":
-			return m, tea.Quit
-
-		case "?":
-			if m.activeView == "help" {
-				m.helpViewer.Hide()
-				m.activeView = "editor"
-			} else {
-				m.activeView = "help"
-				m.helpViewer.Show()
-				m.helpViewer.viewer.Focus()
-			}
-			return m, nil
-
-		case "p":
-			m.activeView = "fuzzy"
-			m.fuzzyFinder.Show()
-			m.fuzzyFinder.Modal.Focus()
-			m.fuzzyFinder.internalFocus = FocusSearch // Start with search
-			m.focusFuzzyContainer()
-			m.fuzzyFinder.input.SetValue("")
-			m.fuzzyFinder.selectedIdx = 0
-			m.updateFuzzyResults("")
-			return m, nil
-
-		case "e":
-			if m.activeView == "explorer" {
-				m.activeView = "editor"
-			} else {
-				m.activeView = "explorer"
-			}
-			return m, nil
-
-		case "s":
-			m.activeView = "settings"
-			m.settings.Show()
-			return m, nil
-
-		case "tab":
-			m.activeTab = (m.activeTab + 1) % len(m.openFiles)
-			m.tabs.SetActive(m.activeTab)
-			m.updateEditorContent()
-			return m, nil
-
-		case "w":
-			// Simulate closing a tab
-			if len(m.openFiles) > 1 {
-				m.openFiles = append(m.openFiles[:m.activeTab], m.openFiles[m.activeTab+1:]...)
-				if m.activeTab >= len(m.openFiles) {
-					m.activeTab = len(m.openFiles) - 1
-				}
-				m.updateTabs()
-			}
-			return m, nil
+		if action := m.keys.Action(msg.String()); action != "" {
+			return m, m.runAction(action)
 		}
 
 		// Route input to active view
 		switch m.activeView {
 		case "explorer":
-			// Explorer navigation would be handled here in a real app
+			m.fileExplorer.HandleKey(msg.String())
 		case "editor":
 			m.editor.HandleInput(msg.String())
-			// Start typing timer when user types in editor
+			// Restart the auto-save countdown when user types in editor
 			if len(msg.String()) == 1 || msg.Type == tea.KeyBackspace || msg.Type == tea.KeyDelete {
-				m.lastTypedTimer = 1
+				return m, m.autoSave.Schedule(time.Now().Add(autoSaveDelay))
 			}
 		}
 	}
@@ -542,35 +630,84 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
-func (m *model) focusFuzzyContainer() {
-	// Blur all containers first
-	m.fuzzyFinder.searchContainer.Blur()
-	m.fuzzyFinder.resultsContainer.Blur()
-	m.fuzzyFinder.previewContainer.Blur()
-
-	// Focus only the current one
-	switch m.fuzzyFinder.internalFocus {
-	case FocusSearch:
-		m.fuzzyFinder.searchContainer.Focus()
-		m.fuzzyFinder.input.Focus()
-	case FocusResults:
-		m.fuzzyFinder.resultsContainer.Focus()
-	case FocusPreview:
-		m.fuzzyFinder.previewContainer.Focus()
-		m.fuzzyFinder.previewViewer.Focus()
+// runAction runs a global action, from a shortcut or a menu
+func (m *model) runAction(action string) tea.Cmd {
+	switch action {
+	case "quit":
+		return tea.Quit
+
+	case "help":
+		if m.activeView == "help" {
+			m.helpViewer.Hide()
+			m.activeView = "editor"
+		} else {
+			m.activeView = "help"
+			m.helpViewer.Show()
+			m.helpViewer.viewer.Focus()
+		}
+
+	case "find-file":
+		m.activeView = "fuzzy"
+		m.overlays.Open(m.fuzzyFinder, m.fuzzyFinder.focusRoot) // Starts with search focused
+		m.fuzzyFinder.input.SetValue("")
+		m.fuzzyFinder.selectedIdx = 0
+		m.updateFuzzyResults("")
+
+	case "toggle-explorer":
+		if m.activeView == "explorer" {
+			m.activeView = "editor"
+		} else {
+			m.activeView = "explorer"
+		}
+
+	case "settings":
+		m.activeView = "settings"
+		m.settings.Show()
+		m.settings.form.Reset()
+		m.settings.form.Focus()
+
+	case "next-tab":
+		m.activeTab = (m.activeTab + 1) % len(m.openFiles)
+		m.tabs.SetActive(m.activeTab)
+		m.updateEditorContent()
+
+	case "close-tab":
+		// Simulate closing a tab
+		if len(m.openFiles) > 1 {
+			m.openFiles = append(m.openFiles[:m.activeTab], m.openFiles[m.activeTab+1:]...)
+			if m.activeTab >= len(m.openFiles) {
+				m.activeTab = len(m.openFiles) - 1
+			}
+			m.updateTabs()
+		}
+
+	case "close-other-tabs":
+		m.openFiles = []string{m.openFiles[m.activeTab]}
+		m.activeTab = 0
+		m.updateTabs()
+
+	case "tab-menu":
+		// Open under the active tab
+		m.tabMenu.Open(m.activeTabX, 2)
 	}
+	return nil
+}
+
+// focusFuzzy moves focus to one of the fuzzy finder's panes
+func (m *model) focusFuzzy(component tui.Focusable) {
+	m.overlays.Scope().Focus(component)
 }
 
 func (m *model) handleEscape() *model {
 	switch m.activeView {
 	case "fuzzy":
-		m.fuzzyFinder.Hide()
-		m.fuzzyFinder.Modal.Blur()
-		m.fuzzyFinder.searchContainer.Blur()
-		m.fuzzyFinder.resultsContainer.Blur()
-		m.fuzzyFinder.previewContainer.Blur()
+		m.overlays.Close()
 	case "settings":
-		m.settings.Hide()
+		// Esc closes an open dropdown before the settings themselves
+		m.settings.form.HandleKey("esc")
+		if m.settings.IsVisible() {
+			return m
+		}
 	case "help":
 		m.helpViewer.Hide()
 	case "explorer":
@@ -604,7 +741,7 @@ func (m *model) View() string {
 
 	editorX := explorerW
 	editorWidth := m.width - explorerW
-	contentHeight := m.height - 2 // Leave room for tabs and status bar
+	contentHeight := m.height - 3 // Leave room for the menu bar, tabs and status bar
 
 	// Ensure minimum sizes
 	if contentHeight < 1 {
@@ -625,46 +762,19 @@ func (m *model) View() string {
 		header := "Files"
 		// Clear the header line first
 		for i := 0; i < explorerW-1; i++ {
-			m.screen.SetCell(i, 0, tui.Cell{
+			m.screen.SetCell(i, 1, tui.Cell{
 				Rune:       ' ',
 				Background: m.theme.Palette.Surface,
 			})
 		}
-		m.screen.DrawString(1, 0, header, headerStyle)
-
-		// Draw file list manually (simpler than using Table)
-		fileStyle := lipgloss.NewStyle().
-			Foreground(m.theme.Palette.Text).
-			Background(m.theme.Palette.Background)
-		selectedStyle := lipgloss.NewStyle().
-			Foreground(m.theme.Palette.Background).
-			Background(m.theme.Palette.Primary)
-
-		files := explorerFiles
-
-		for i, file := range files {
-			y := i + 1 // Start after header
-			if y >= contentHeight {
-				break
-			}
-
-			// Pad file name to fill width
-			paddedFile := file
-			if len(paddedFile) < explorerW-1 {
-				paddedFile += strings.Repeat(" ", explorerW-1-len(paddedFile))
-			}
-
-			style := fileStyle
-			// Simple selection highlight (would track in real app)
-			if i == 1 && m.activeView == "explorer" { // Highlight second item as example
-				style = selectedStyle
-			}
+		m.screen.DrawString(1, 1, header, headerStyle)
 
-			m.screen.DrawString(0, y, paddedFile, style)
-		}
+		// Draw the file tree below the header
+		m.fileExplorer.Focus()
+		m.fileExplorer.Draw(m.screen, 0, 2, explorerW-1, contentHeight-1, &m.theme)
 
 		// Draw separator
-		for y := 0; y < contentHeight; y++ {
+		for y := 1; y <= contentHeight; y++ {
 			m.screen.SetCell(explorerW-1, y, tui.Cell{
 				Rune:       '│',
 				Foreground: m.theme.Palette.Border,
@@ -673,19 +783,22 @@ func (m *model) View() string {
 	}
 
 	// Draw custom tab bar
-	m.drawTabBar(editorX, 0, editorWidth)
+	m.drawTabBar(editorX, 1, editorWidth)
 
-	// Draw editor (start at line 1 after tabs)
+	// Draw editor (start at line 2 after the menu bar and tabs)
 	m.editor.SetSize(editorWidth, contentHeight-1)
-	m.editor.Draw(m.screen, editorX, 1, editorWidth, contentHeight-1, &m.theme)
+	m.editor.Draw(m.screen, editorX, 2, editorWidth, contentHeight-1, &m.theme)
+
+	// Draw the menu bar; open menus land on top when the screen renders
+	m.explorerItem.Checked = m.activeView == "explorer"
+	m.menuBar.Draw(m.screen, 0, 0, m.width, 1, &m.theme)
+	m.tabMenu.Draw(m.screen, 0, 0, m.width, m.height, &m.theme)
 
 	// Draw status bar
 	m.drawStatusBar()
 
 	// Draw overlays
 	if m.activeView == "fuzzy" {
-		// Ensure proper focus state when drawing
-		m.focusFuzzyContainer()
 		m.drawFuzzyFinder()
 	} else if m.activeView == "settings" {
 		m.drawSettings()
@@ -703,40 +816,28 @@ func (m *model) handleFuzzyInput(msg tea.KeyMsg) {
 	switch msg.String() {
 	case "up", "ctrl+p":
 		// Auto-shift to results and navigate up
-		if m.fuzzyFinder.internalFocus != FocusResults {
-			m.fuzzyFinder.internalFocus = FocusResults
-			m.focusFuzzyContainer()
-		}
+		m.focusFuzzy(m.fuzzyFinder.resultsContainer)
 		if m.fuzzyFinder.selectedIdx > 0 {
 			m.fuzzyFinder.selectedIdx--
 		}
 
 	case "down", "ctrl+n":
 		// Auto-shift to results and navigate down
-		if m.fuzzyFinder.internalFocus != FocusResults {
-			m.fuzzyFinder.internalFocus = FocusResults
-			m.focusFuzzyContainer()
-		}
+		m.focusFuzzy(m.fuzzyFinder.resultsContainer)
 		if m.fuzzyFinder.selectedIdx < len(m.fuzzyFinder.filtered)-1 && m.fuzzyFinder.selectedIdx < maxFuzzyResults-1 {
 			m.fuzzyFinder.selectedIdx++
 		}
 
 	case "ctrl+u":
 		// Auto-shift to preview and page up
-		if m.fuzzyFinder.internalFocus != FocusPreview {
-			m.fuzzyFinder.internalFocus = FocusPreview
-			m.focusFuzzyContainer()
-		}
+		m.focusFuzzy(m.fuzzyFinder.previewViewer)
 		for i := 0; i < 5; i++ {
 			m.fuzzyFinder.previewViewer.HandleInput("up")
 		}
 
 	case "ctrl+d":
 		// Auto-shift to preview and page down
-		if m.fuzzyFinder.internalFocus != FocusPreview {
-			m.fuzzyFinder.internalFocus = FocusPreview
-			m.focusFuzzyContainer()
-		}
+		m.focusFuzzy(m.fuzzyFinder.previewViewer)
 		for i := 0; i < 5; i++ {
 			m.fuzzyFinder.previewViewer.HandleInput("down")
 		}
@@ -746,14 +847,14 @@ func (m *model) handleFuzzyInput(msg tea.KeyMsg) {
 			// Open selected file
 			filename := m.fuzzyFinder.filtered[m.fuzzyFinder.selectedIdx]
 			m.openFile(filename)
-			m.fuzzyFinder.Hide()
-			m.fuzzyFinder.Modal.Blur()
-			m.fuzzyFinder.searchContainer.Blur()
-			m.fuzzyFinder.resultsContainer.Blur()
-			m.fuzzyFinder.previewContainer.Blur()
+			m.overlays.Close()
 			m.activeView = "editor"
 		}
 
+	case "tab", "shift+tab":
+		// Cycle between search, results and preview
+		m.overlays.HandleKey(msg.String())
+
 	case "escape", "esc":
 		// Close fuzzy finder without action
 		return
@@ -761,10 +862,7 @@ func (m *model) handleFuzzyInput(msg tea.KeyMsg) {
 	default:
 		// Auto-shift to search for typing
 		if len(msg.String()) == 1 || msg.Type == tea.KeyBackspace || msg.Type == tea.KeyDelete {
-			if m.fuzzyFinder.internalFocus != FocusSearch {
-				m.fuzzyFinder.internalFocus = FocusSearch
-				m.focusFuzzyContainer()
-			}
+			m.focusFuzzy(m.fuzzyFinder.input)
 			m.fuzzyFinder.input.HandleInput(msg.String())
 			m.updateFuzzyResults(m.fuzzyFinder.input.Value())
 		}
@@ -774,16 +872,9 @@ func (m *model) handleFuzzyInput(msg tea.KeyMsg) {
 func (m *model) updateFuzzyResults(query string) {
 	m.fuzzyFinder.filtered = []string{}
 
-	if query == "" {
-		// Show all files when query is empty
-		m.fuzzyFinder.filtered = m.fuzzyFinder.allFiles
-	} else {
-		query = strings.ToLower(query)
-		for _, file := range m.fuzzyFinder.allFiles {
-			if strings.Contains(strings.ToLower(file), query) {
-				m.fuzzyFinder.filtered = append(m.fuzzyFinder.filtered, file)
-			}
-		}
+	// Best matches first; an empty query shows all files in order
+	for _, result := range tui.FuzzyFilter(query, m.fuzzyFinder.allFiles) {
+		m.fuzzyFinder.filtered = append(m.fuzzyFinder.filtered, m.fuzzyFinder.allFiles[result.Index])
 	}
 
 	// Reset selection when results change
@@ -791,24 +882,9 @@ func (m *model) updateFuzzyResults(query string) {
 }
 
 func (m *model) handleSettingsInput(msg tea.KeyMsg) {
-	switch msg.String() {
-	case "up", "k":
-		if m.settings.selectedIdx > 0 {
-			m.settings.selectedIdx--
-		}
-	case "down", "j":
-		if m.settings.selectedIdx < len(m.settings.options)-1 {
-			m.settings.selectedIdx++
-		}
-	case "enter", "space":
-		opt := &m.settings.options[m.settings.selectedIdx]
-		if opt.kind == "toggle" {
-			if opt.value == "On" {
-				opt.value = "Off"
-			} else {
-				opt.value = "On"
-			}
-		}
+	m.settings.form.HandleKey(msg.String())
+	if !m.settings.IsVisible() {
+		m.activeView = "editor"
 	}
 }
 
@@ -887,6 +963,9 @@ func (m *model) drawTabBar(x, y, width int) {
 		}
 
 		if currentX+len(tabText) < x+width {
+			if i == m.activeTab {
+				m.activeTabX = currentX
+			}
 			m.screen.DrawString(currentX, y, tabText, style)
 			currentX += len(tabText)
 
@@ -905,7 +984,7 @@ func (m *model) drawStatusBar() {
 	m.statusBar.AddSegment("NORMAL", "left")
 	m.statusBar.AddSegment(fmt.Sprintf("%s | %s", m.openFiles[m.activeTab], getFileType(m.openFiles[m.activeTab])), "left")
 	m.statusBar.AddSegment("Ln 1, Col 1", "center")
-	m.statusBar.AddSegment("?:help p:find e:explore s:settings q:quit", "right")
+	m.statusBar.AddSegment("F10:menu ?:help p:find e:explore s:settings q:quit", "right")
 
 	// Draw the status bar
 	m.statusBar.Draw(m.screen, 0, m.height-1, m.width, 1, &m.theme)
@@ -1016,69 +1095,14 @@ func (m *model) drawSettings() {
 	container.SetPadding(tui.NewMargin(1))
 	container.Draw(m.screen, modalX, modalY, settingsWidth, settingsHeight, &m.theme)
 
-	// Adjust positions for container content
-	contentX := modalX + 2 // Container border + padding
-	contentY := modalY + 2 // Container border + title + padding
-
-	// Draw settings options
-	textStyle := lipgloss.NewStyle().
-		Foreground(m.theme.Palette.Text).
-		Background(m.theme.Palette.Surface)
-	selectedStyle := lipgloss.NewStyle().
-		Foreground(m.theme.Palette.Background).
-		Background(m.theme.Palette.Primary)
-
-	for i, opt := range m.settings.options {
-		y := contentY + i // Start inside container content area
-
-		// Create full-width selection bar
-		style := textStyle
-		if i == m.settings.selectedIdx {
-			// Fill entire row with selection background, respecting container padding
-			for x := contentX; x < modalX+settingsWidth-2; x++ {
-				m.screen.SetCell(x, y, tui.Cell{
-					Rune:       ' ',
-					Background: m.theme.Palette.Primary,
-				})
-			}
-			style = selectedStyle
-		}
-
-		// Draw option name
-		label := opt.name
-		if len(label) > 25 {
-			label = label[:25]
-		}
-		m.screen.DrawString(contentX, y, label, style)
-
-		// Draw value aligned to the right
-		valueStyle := style
-		if opt.kind == "toggle" {
-			if opt.value == "On" {
-				valueStyle = lipgloss.NewStyle().
-					Foreground(m.theme.Palette.Pine).
-					Background(style.GetBackground())
-			} else {
-				valueStyle = lipgloss.NewStyle().
-					Foreground(m.theme.Palette.TextMuted).
-					Background(style.GetBackground())
-			}
-		}
-
-		value := opt.value
-		if len(value) > 12 {
-			value = value[:12]
-		}
-		// Right-align the value
-		valueX := modalX + settingsWidth - 3 - len(value)
-		m.screen.DrawString(valueX, y, value, valueStyle)
-	}
+	// Draw the settings form inside the container
+	m.settings.form.Draw(m.screen, modalX+2, modalY+2, settingsWidth-4, settingsHeight-5, &m.theme)
 
 	// Draw help text at bottom of container
 	helpStyle := lipgloss.NewStyle().
 		Foreground(m.theme.Palette.TextMuted).
 		Background(m.theme.Palette.Surface)
-	helpText := "↑↓ Navigate  Space/Enter Toggle  Esc Close"
+	helpText := "↑↓ Navigate  Space Toggle  Esc Close"
 	// Center the help text
 	helpX := modalX + (settingsWidth-len(helpText))/2
 	m.screen.DrawString(helpX, modalY+settingsHeight-2, helpText, helpStyle)
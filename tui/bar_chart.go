@@ -0,0 +1,257 @@
+package tui
+
+import (
+	"math"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Bar is one labeled value in a BarChart
+// Bars without a Role are colored by their position.
+type Bar struct {
+	Label string
+	Value float64
+	Role  ColorRole
+}
+
+// BarChart compares labeled values as bars
+//
+// Vertical charts have a value axis on the left and the labels under the
+// bars; horizontal charts put one bar per row with its label before it and
+// its value after. Bars fill with eighth-of-a-cell precision and the range
+// is worked out from the values, reaching down or up to zero, unless fixed
+// with SetRange. Bars grow from the bottom of the range, so with negative
+// values the shortest bar is the most negative one.
+type BarChart struct {
+	bars       []Bar
+	horizontal bool
+	barWidth   int
+	gap        int
+	showValues bool
+	scale      chartScale
+	format     func(value float64) string
+}
+
+// NewBarChart creates a vertical bar chart
+func NewBarChart(bars ...Bar) *BarChart {
+	return &BarChart{
+		bars:       bars,
+		barWidth:   3,
+		gap:        1,
+		showValues: true,
+		format:     FormatChartValue,
+	}
+}
+
+// SetBars replaces the bars
+func (c *BarChart) SetBars(bars ...Bar) {
+	c.bars = bars
+}
+
+// Bars returns the bars
+func (c *BarChart) Bars() []Bar {
+	return c.bars
+}
+
+// SetValue sets the value of the bar with the given label, adding the bar
+// if there isn't one
+func (c *BarChart) SetValue(label string, value float64) {
+	for i := range c.bars {
+		if c.bars[i].Label == label {
+			c.bars[i].Value = value
+			return
+		}
+	}
+	c.bars = append(c.bars, Bar{Label: label, Value: value})
+}
+
+// SetHorizontal sets whether bars run left to right, one per row
+func (c *BarChart) SetHorizontal(horizontal bool) {
+	c.horizontal = horizontal
+}
+
+// SetBarWidth sets how many columns each vertical bar takes
+func (c *BarChart) SetBarWidth(width int) {
+	c.barWidth = max(1, width)
+}
+
+// SetGap sets the columns between vertical bars
+func (c *BarChart) SetGap(gap int) {
+	c.gap = max(0, gap)
+}
+
+// SetShowValues sets whether each bar's value is written next to it
+func (c *BarChart) SetShowValues(show bool) {
+	c.showValues = show
+}
+
+// SetRange fixes the values at the ends of the value axis
+func (c *BarChart) SetRange(low, high float64) {
+	c.scale = chartScale{low: low, high: high, fixed: true}
+}
+
+// SetAutoRange scales the chart to its values, the default
+func (c *BarChart) SetAutoRange() {
+	c.scale = chartScale{}
+}
+
+// SetFormat sets how values and axis labels are written
+func (c *BarChart) SetFormat(format func(value float64) string) {
+	if format == nil {
+		format = FormatChartValue
+	}
+	c.format = format
+}
+
+// bounds returns the range the bars are drawn in
+func (c *BarChart) bounds() (low, high float64) {
+	values := make([]float64, len(c.bars))
+	for i, bar := range c.bars {
+		values[i] = bar.Value
+	}
+	return c.scale.bounds(values, true)
+}
+
+// barStyle returns the style bar i is drawn in
+func (c *BarChart) barStyle(i int, theme *Theme) lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(seriesRole(c.bars[i].Role, i).Color(theme.Palette))
+}
+
+// axisWidth returns the width of the value axis labels
+func (c *BarChart) axisWidth(low, high float64) int {
+	return max(StringWidth(c.format(low)), StringWidth(c.format(high)))
+}
+
+// labelWidth returns the width of the widest bar label
+func (c *BarChart) labelWidth() int {
+	width := 0
+	for _, bar := range c.bars {
+		width = max(width, StringWidth(bar.Label))
+	}
+	return width
+}
+
+// Measure returns the room the bars need
+// Vertical charts ask for ten rows; horizontal ones for forty columns.
+func (c *BarChart) Measure(maxWidth, maxHeight int) (width, height int) {
+	if c.horizontal {
+		return min(40, maxWidth), min(len(c.bars), maxHeight)
+	}
+	low, high := c.bounds()
+	width = c.axisWidth(low, high) + 1 + len(c.bars)*(c.barWidth+c.gap) + c.gap
+	return min(width, maxWidth), min(10, maxHeight)
+}
+
+// Draw renders the chart
+func (c *BarChart) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	if availableWidth <= 0 || availableHeight <= 0 || len(c.bars) == 0 {
+		return
+	}
+	if c.horizontal {
+		c.drawHorizontal(screen, x, y, availableWidth, availableHeight, theme)
+		return
+	}
+	c.drawVertical(screen, x, y, availableWidth, availableHeight, theme)
+}
+
+// drawHorizontal draws one bar per row: label, bar, value
+func (c *BarChart) drawHorizontal(screen *Screen, x, y, width, height int, theme *Theme) {
+	low, high := c.bounds()
+	labelStyle := lipgloss.NewStyle().Foreground(theme.Palette.TextMuted)
+	valueStyle := lipgloss.NewStyle().Foreground(theme.Palette.Text)
+
+	labelWidth := min(c.labelWidth(), width/3)
+	valueWidth := 0
+	if c.showValues {
+		for _, bar := range c.bars {
+			valueWidth = max(valueWidth, StringWidth(c.format(bar.Value)))
+		}
+	}
+	barX := x
+	if labelWidth > 0 {
+		barX += labelWidth + 1
+	}
+	barWidth := x + width - barX
+	if valueWidth > 0 && barWidth > valueWidth+1 {
+		barWidth -= valueWidth + 1
+	} else {
+		valueWidth = 0
+	}
+
+	for i, bar := range c.bars[:min(len(c.bars), height)] {
+		row := y + i
+		screen.DrawString(x, row, Truncate(bar.Label, labelWidth), labelStyle)
+		drawFill(screen, barX, row, barWidth, fraction(bar.Value, low, high), c.barStyle(i, theme))
+		if valueWidth > 0 {
+			screen.DrawString(barX+barWidth+1, row, c.format(bar.Value), valueStyle)
+		}
+	}
+}
+
+// drawVertical draws the value axis, the bars rising from the bottom and the
+// labels under them
+func (c *BarChart) drawVertical(screen *Screen, x, y, width, height int, theme *Theme) {
+	low, high := c.bounds()
+	axisStyle := lipgloss.NewStyle().Foreground(theme.Palette.Border)
+	labelStyle := lipgloss.NewStyle().Foreground(theme.Palette.TextMuted)
+	valueStyle := lipgloss.NewStyle().Foreground(theme.Palette.Text)
+
+	// The bottom two rows hold the baseline and the labels
+	plotHeight := height - 2
+	if plotHeight < 1 {
+		plotHeight = height
+	}
+	bottom := y + plotHeight - 1
+
+	axisX := x + drawValueAxis(screen, x, y, plotHeight, low, high, c.format, axisStyle, labelStyle)
+	if plotHeight < height {
+		screen.DrawRune(axisX, bottom+1, '└', axisStyle)
+		for col := axisX + 1; col < x+width; col++ {
+			screen.DrawRune(col, bottom+1, '─', axisStyle)
+		}
+	}
+
+	barX := axisX + 1 + c.gap
+	for i, bar := range c.bars {
+		if barX >= x+width {
+			break
+		}
+		barWidth := min(c.barWidth, x+width-barX)
+		filled := fraction(bar.Value, low, high)
+		drawColumn(screen, barX, bottom, barWidth, plotHeight, filled, c.barStyle(i, theme))
+
+		if c.showValues {
+			// The value sits on the row above the top of the bar
+			top := bottom - int(math.Ceil(filled*float64(plotHeight)))
+			if top >= y {
+				value := Truncate(c.format(bar.Value), barWidth)
+				screen.DrawString(barX+(barWidth-StringWidth(value))/2, top, value, valueStyle)
+			}
+		}
+		if plotHeight+1 < height {
+			label := Truncate(bar.Label, barWidth+c.gap)
+			screen.DrawString(barX+max(0, (barWidth-StringWidth(label))/2), bottom+2, label, labelStyle)
+		}
+		barX += barWidth + c.gap
+	}
+}
+
+// drawValueAxis draws high and low labels down the left of a plot with a
+// vertical line after them, and returns the line's offset from x
+func drawValueAxis(screen *Screen, x, y, height int, low, high float64, format func(float64) string, axisStyle, labelStyle lipgloss.Style) int {
+	top, bottom := format(high), format(low)
+	width := max(StringWidth(top), StringWidth(bottom))
+	for row := 0; row < height; row++ {
+		screen.DrawRune(x+width, y+row, '│', axisStyle)
+	}
+	screen.DrawString(x+width-StringWidth(top), y, top, labelStyle)
+	screen.DrawRune(x+width, y, '┤', axisStyle)
+	if height > 1 {
+		screen.DrawString(x+width-StringWidth(bottom), y+height-1, bottom, labelStyle)
+		screen.DrawRune(x+width, y+height-1, '┤', axisStyle)
+	}
+	return width
+}
+
+// HandleInput does nothing; bar charts don't take input
+func (c *BarChart) HandleInput(key string) {}
@@ -0,0 +1,363 @@
+package tui
+
+import (
+	"math"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+// CanvasMode is how a Canvas divides each cell into pixels
+type CanvasMode int
+
+const (
+	// CanvasBraille gives each cell two pixels across and four down, drawn
+	// as braille dots. It has the finest resolution, but each cell takes a
+	// single color.
+	CanvasBraille CanvasMode = iota
+	// CanvasHalfBlock gives each cell one pixel across and two down, drawn
+	// with half blocks. Both pixels in a cell keep their own color.
+	CanvasHalfBlock
+)
+
+// pixels returns how many pixels across and down each cell holds
+func (m CanvasMode) pixels() (width, height int) {
+	if m == CanvasHalfBlock {
+		return 1, 2
+	}
+	return 2, 4
+}
+
+// brailleDots are the braille pattern bits for each dot of a cell, by column
+// then row
+var brailleDots = [2][4]rune{
+	{0x01, 0x02, 0x04, 0x40},
+	{0x08, 0x10, 0x20, 0x80},
+}
+
+// canvasCell is the pixels set in one cell and their colors
+// Braille cells use the first role for every dot; half-block cells have a
+// role for the top and bottom pixel.
+type canvasCell struct {
+	dots  rune
+	roles [2]ColorRole
+}
+
+// canvasLabel is text placed on the canvas at a cell
+type canvasLabel struct {
+	x, y int
+	text string
+	role ColorRole
+}
+
+// CanvasPainter draws on a grid of pixels finer than the screen's cells
+//
+// Coordinates are in pixels from the top left, and anything outside the
+// grid is clipped. Each shape takes a palette role; where shapes share a
+// cell, the one drawn last decides its color.
+type CanvasPainter struct {
+	mode          CanvasMode
+	width, height int // in cells
+	cells         []canvasCell
+	labels        []canvasLabel
+}
+
+// newCanvasPainter creates a blank painter covering width by height cells
+func newCanvasPainter(mode CanvasMode, width, height int) *CanvasPainter {
+	width, height = max(0, width), max(0, height)
+	return &CanvasPainter{
+		mode:   mode,
+		width:  width,
+		height: height,
+		cells:  make([]canvasCell, width*height),
+	}
+}
+
+// Width returns the width of the grid in pixels
+func (p *CanvasPainter) Width() int {
+	across, _ := p.mode.pixels()
+	return p.width * across
+}
+
+// Height returns the height of the grid in pixels
+func (p *CanvasPainter) Height() int {
+	_, down := p.mode.pixels()
+	return p.height * down
+}
+
+// Point sets a single pixel
+func (p *CanvasPainter) Point(x, y int, role ColorRole) {
+	if x < 0 || y < 0 || x >= p.Width() || y >= p.Height() {
+		return
+	}
+	across, down := p.mode.pixels()
+	cell := &p.cells[(y/down)*p.width+x/across]
+	if p.mode == CanvasHalfBlock {
+		cell.dots |= 1 << (y % 2)
+		cell.roles[y%2] = role
+		return
+	}
+	cell.dots |= brailleDots[x%2][y%4]
+	cell.roles[0] = role
+}
+
+// Line draws a straight line between two pixels, both included
+func (p *CanvasPainter) Line(x0, y0, x1, y1 int, role ColorRole) {
+	x0, y0, x1, y1, visible := p.clipLine(x0, y0, x1, y1)
+	if !visible {
+		return
+	}
+	// Bresenham's algorithm, for every octant
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+	err := dx + dy
+	for {
+		p.Point(x0, y0, role)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// clipLine cuts a line down to the part inside the grid, so lines running
+// far off the canvas don't step through every pixel outside it
+// Lines already inside are returned unchanged. Returns false when no part
+// of the line is inside.
+func (p *CanvasPainter) clipLine(x0, y0, x1, y1 int) (int, int, int, int, bool) {
+	right, bottom := p.Width()-1, p.Height()-1
+	inside := func(x, y int) bool { return x >= 0 && y >= 0 && x <= right && y <= bottom }
+	if inside(x0, y0) && inside(x1, y1) {
+		return x0, y0, x1, y1, true
+	}
+	if right < 0 || bottom < 0 {
+		return 0, 0, 0, 0, false
+	}
+
+	// Liang-Barsky: narrow the part of the line, from 0 to 1, inside each
+	// edge in turn
+	fromX, fromY := float64(x0), float64(y0)
+	dx, dy := float64(x1)-fromX, float64(y1)-fromY
+	start, end := 0.0, 1.0
+	edges := [4][2]float64{
+		{-dx, fromX},
+		{dx, float64(right) - fromX},
+		{-dy, fromY},
+		{dy, float64(bottom) - fromY},
+	}
+	for _, edge := range edges {
+		direction, distance := edge[0], edge[1]
+		if direction == 0 {
+			// Parallel to the edge, so either all inside it or all outside
+			if distance < 0 {
+				return 0, 0, 0, 0, false
+			}
+			continue
+		}
+		t := distance / direction
+		if direction < 0 {
+			start = math.Max(start, t)
+		} else {
+			end = math.Min(end, t)
+		}
+	}
+	if start > end {
+		return 0, 0, 0, 0, false
+	}
+	point := func(t float64) (int, int) {
+		return int(math.Round(fromX + t*dx)), int(math.Round(fromY + t*dy))
+	}
+	x0, y0 = point(start)
+	x1, y1 = point(end)
+	return x0, y0, x1, y1, true
+}
+
+// Rect draws the outline of a rectangle with its top left at x, y
+func (p *CanvasPainter) Rect(x, y, width, height int, role ColorRole) {
+	if width <= 0 || height <= 0 {
+		return
+	}
+	right, bottom := x+width-1, y+height-1
+	p.Line(x, y, right, y, role)
+	p.Line(x, bottom, right, bottom, role)
+	p.Line(x, y, x, bottom, role)
+	p.Line(right, y, right, bottom, role)
+}
+
+// FillRect fills a rectangle with its top left at x, y
+func (p *CanvasPainter) FillRect(x, y, width, height int, role ColorRole) {
+	for row := max(y, 0); row < min(y+height, p.Height()); row++ {
+		for col := max(x, 0); col < min(x+width, p.Width()); col++ {
+			p.Point(col, row, role)
+		}
+	}
+}
+
+// Circle draws the outline of a circle around a center pixel
+func (p *CanvasPainter) Circle(cx, cy, radius int, role ColorRole) {
+	if radius < 0 {
+		return
+	}
+	// The midpoint algorithm, mirrored into all eight octants
+	x, y, err := radius, 0, 1-radius
+	for x >= y {
+		for _, point := range [][2]int{{x, y}, {y, x}, {-y, x}, {-x, y}, {-x, -y}, {-y, -x}, {y, -x}, {x, -y}} {
+			p.Point(cx+point[0], cy+point[1], role)
+		}
+		y++
+		if err < 0 {
+			err += 2*y + 1
+		} else {
+			x--
+			err += 2*(y-x) + 1
+		}
+	}
+}
+
+// FillCircle fills a circle around a center pixel
+func (p *CanvasPainter) FillCircle(cx, cy, radius int, role ColorRole) {
+	for dy := -radius; dy <= radius; dy++ {
+		dx := int(math.Sqrt(float64(radius*radius - dy*dy)))
+		p.Line(cx-dx, cy+dy, cx+dx, cy+dy, role)
+	}
+}
+
+// Text writes a label starting in the cell that holds pixel x, y
+// Labels are drawn over the pixels and cut off at the canvas edge.
+func (p *CanvasPainter) Text(x, y int, text string, role ColorRole) {
+	across, down := p.mode.pixels()
+	p.labels = append(p.labels, canvasLabel{x: floorDiv(x, across), y: floorDiv(y, down), text: text, role: role})
+}
+
+// render draws the pixels and labels onto the screen
+func (p *CanvasPainter) render(screen *Screen, x, y int, theme *Theme) {
+	for i, cell := range p.cells {
+		if cell.dots == 0 {
+			continue
+		}
+		col, row := x+i%p.width, y+i/p.width
+		if p.mode == CanvasBraille {
+			style := lipgloss.NewStyle().Foreground(cell.roles[0].Color(theme.Palette))
+			screen.DrawRune(col, row, 0x2800+cell.dots, style)
+			continue
+		}
+		top := lipgloss.NewStyle().Foreground(cell.roles[0].Color(theme.Palette))
+		bottom := lipgloss.NewStyle().Foreground(cell.roles[1].Color(theme.Palette))
+		switch {
+		case cell.dots == 1:
+			screen.DrawRune(col, row, '▀', top)
+		case cell.dots == 2:
+			screen.DrawRune(col, row, '▄', bottom)
+		case cell.roles[0] == cell.roles[1]:
+			screen.DrawRune(col, row, '█', top)
+		default:
+			// The upper half takes the foreground and the lower the
+			// background
+			screen.DrawRune(col, row, '▀', top.Background(cell.roles[1].Color(theme.Palette)))
+		}
+	}
+
+	for _, label := range p.labels {
+		if label.y < 0 || label.y >= p.height || label.x >= p.width {
+			continue
+		}
+		text, col := label.text, label.x
+		if col < 0 {
+			// Drop the part of the label left of the canvas
+			text = runewidth.TruncateLeft(text, -col, "")
+			col = 0
+		}
+		style := lipgloss.NewStyle().Foreground(label.role.Color(theme.Palette))
+		screen.DrawString(x+col, y+label.y, Truncate(text, p.width-col), style)
+	}
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// floorDiv divides rounding towards negative infinity, so pixels left of
+// or above the canvas map to cells outside it
+func floorDiv(a, b int) int {
+	if a < 0 {
+		return -((-a + b - 1) / b)
+	}
+	return a / b
+}
+
+// Canvas is a component for free-form drawing at a finer resolution than
+// the screen's cells
+//
+// The function given to OnPaint draws the picture with a CanvasPainter each
+// time the canvas is drawn, sized to the space it's given, so pictures can
+// adapt to the canvas size through the painter's Width and Height.
+type Canvas struct {
+	mode          CanvasMode
+	paint         func(p *CanvasPainter)
+	width, height int
+}
+
+// NewCanvas creates a blank canvas with the given pixel layout
+func NewCanvas(mode CanvasMode) *Canvas {
+	return &Canvas{
+		mode:   mode,
+		width:  40,
+		height: 10,
+	}
+}
+
+// SetMode sets how cells are divided into pixels
+func (c *Canvas) SetMode(mode CanvasMode) {
+	c.mode = mode
+}
+
+// Mode returns how cells are divided into pixels
+func (c *Canvas) Mode() CanvasMode {
+	return c.mode
+}
+
+// OnPaint sets the function that draws the picture
+func (c *Canvas) OnPaint(paint func(p *CanvasPainter)) {
+	c.paint = paint
+}
+
+// SetSize sets the preferred size in cells reported by Measure
+func (c *Canvas) SetSize(width, height int) {
+	c.width, c.height = width, height
+}
+
+// Measure returns the preferred size
+func (c *Canvas) Measure(maxWidth, maxHeight int) (width, height int) {
+	return min(c.width, maxWidth), min(c.height, maxHeight)
+}
+
+// Draw paints the picture into the available space
+func (c *Canvas) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	if availableWidth <= 0 || availableHeight <= 0 || c.paint == nil {
+		return
+	}
+	painter := newCanvasPainter(c.mode, availableWidth, availableHeight)
+	c.paint(painter)
+	painter.render(screen, x, y, theme)
+}
+
+// HandleInput does nothing; canvases don't take input
+func (c *Canvas) HandleInput(key string) {}
@@ -0,0 +1,173 @@
+package tui
+
+import (
+	"testing"
+)
+
+func paintCanvas(mode CanvasMode, width, height int, theme *Theme, paint func(p *CanvasPainter)) *ScreenSimulation {
+	canvas := NewCanvas(mode)
+	canvas.OnPaint(paint)
+	sim := NewScreenSimulation(width, height)
+	canvas.Draw(sim.Screen, 0, 0, width, height, theme)
+	return sim
+}
+
+func TestCanvasPainterSize(t *testing.T) {
+	var braille, half [2]int
+	paintCanvas(CanvasBraille, 10, 3, NewTestTheme(), func(p *CanvasPainter) {
+		braille = [2]int{p.Width(), p.Height()}
+	})
+	paintCanvas(CanvasHalfBlock, 10, 3, NewTestTheme(), func(p *CanvasPainter) {
+		half = [2]int{p.Width(), p.Height()}
+	})
+	if braille != [2]int{20, 12} {
+		t.Errorf("Expected a 20x12 braille grid, got %v", braille)
+	}
+	if half != [2]int{10, 6} {
+		t.Errorf("Expected a 10x6 half-block grid, got %v", half)
+	}
+}
+
+func TestCanvasBraillePoints(t *testing.T) {
+	sim := paintCanvas(CanvasBraille, 2, 1, NewTestTheme(), func(p *CanvasPainter) {
+		p.Point(0, 0, RolePrimary)
+		p.Point(1, 3, RolePrimary)
+		p.Point(3, 1, RolePrimary)
+		// Outside the grid
+		p.Point(-1, 0, RolePrimary)
+		p.Point(4, 0, RolePrimary)
+		p.Point(0, 4, RolePrimary)
+	})
+	AssertCellRune(t, sim, 0, 0, rune(0x2800|0x01|0x80))
+	AssertCellRune(t, sim, 1, 0, rune(0x2800|0x10))
+}
+
+func TestCanvasLine(t *testing.T) {
+	sim := paintCanvas(CanvasHalfBlock, 4, 2, NewTestTheme(), func(p *CanvasPainter) {
+		p.Line(0, 0, 3, 3, RolePrimary)
+	})
+	// A diagonal one pixel per row
+	if top, bottom := sim.GetLine(0), sim.GetLine(1); top != "▀▄" || bottom != "  ▀▄" {
+		t.Errorf("Expected a diagonal, got %q over %q", top, bottom)
+	}
+
+	// Drawing backwards sets the same pixels
+	backwards := paintCanvas(CanvasHalfBlock, 4, 2, NewTestTheme(), func(p *CanvasPainter) {
+		p.Line(3, 3, 0, 0, RolePrimary)
+	})
+	for y := 0; y < 2; y++ {
+		if backwards.GetLine(y) != sim.GetLine(y) {
+			t.Errorf("Row %d: expected %q, got %q", y, sim.GetLine(y), backwards.GetLine(y))
+		}
+	}
+
+	// Lines far off the canvas are clipped rather than stepped through
+	clipped := paintCanvas(CanvasHalfBlock, 4, 2, NewTestTheme(), func(p *CanvasPainter) {
+		p.Line(-1_000_000_000, 0, 1_000_000_000, 0, RolePrimary)
+		p.Line(-1_000_000_000, -1_000_000_000, 1_000_000_000, 1_000_000_000, RolePrimary)
+		p.Line(-5, 10, 10, 10, RolePrimary)
+	})
+	if top, bottom := clipped.GetLine(0), clipped.GetLine(1); top != "▀█▀▀" || bottom != "  ▀▄" {
+		t.Errorf("Expected the visible parts drawn, got %q over %q", top, bottom)
+	}
+}
+
+func TestCanvasRect(t *testing.T) {
+	sim := paintCanvas(CanvasHalfBlock, 5, 3, NewTestTheme(), func(p *CanvasPainter) {
+		p.Rect(0, 0, 5, 6, RolePrimary)
+		p.FillRect(2, 2, 1, 2, RolePrimary)
+	})
+	expected := []string{
+		"█▀▀▀█",
+		"█ █ █",
+		"█▄▄▄█",
+	}
+	for i, want := range expected {
+		if line := sim.GetLine(i); line != want {
+			t.Errorf("Row %d: expected %q, got %q", i, want, line)
+		}
+	}
+}
+
+func TestCanvasCircle(t *testing.T) {
+	sim := paintCanvas(CanvasHalfBlock, 7, 4, NewTestTheme(), func(p *CanvasPainter) {
+		p.Circle(3, 3, 3, RolePrimary)
+	})
+	expected := []string{
+		" ▄▀▀▀▄",
+		"█     █",
+		"▀▄   ▄▀",
+		"  ▀▀▀",
+	}
+	for i, want := range expected {
+		if line := sim.GetLine(i); line != want {
+			t.Errorf("Row %d: expected %q, got %q", i, want, line)
+		}
+	}
+
+	filled := paintCanvas(CanvasHalfBlock, 7, 4, NewTestTheme(), func(p *CanvasPainter) {
+		p.FillCircle(3, 3, 3, RolePrimary)
+	})
+	if line := filled.GetLine(1); line != "▄█████▄" {
+		t.Errorf("Expected a filled row widening at its middle, got %q", line)
+	}
+}
+
+func TestCanvasHalfBlockColors(t *testing.T) {
+	theme := GetTheme("tokyonight")
+	sim := paintCanvas(CanvasHalfBlock, 3, 1, &theme, func(p *CanvasPainter) {
+		p.Point(0, 0, RoleLove)
+		p.Point(0, 1, RoleFoam)
+		p.Point(1, 0, RoleGold)
+		p.Point(1, 1, RoleGold)
+		p.Point(2, 1, RolePine)
+	})
+
+	// Two colors share a cell through the background
+	mixed, _ := sim.Screen.cellAt(0, 0)
+	if mixed.Rune != '▀' || mixed.Foreground != theme.Palette.Love || mixed.Background != theme.Palette.Foam {
+		t.Errorf("Expected love over foam, got %q %v on %v", mixed.Rune, mixed.Foreground, mixed.Background)
+	}
+	if full, _ := sim.Screen.cellAt(1, 0); full.Rune != '█' || full.Foreground != theme.Palette.Gold {
+		t.Errorf("Expected a full gold block, got %q %v", full.Rune, full.Foreground)
+	}
+	if lower, _ := sim.Screen.cellAt(2, 0); lower.Rune != '▄' || lower.Foreground != theme.Palette.Pine {
+		t.Errorf("Expected a lower pine block, got %q %v", lower.Rune, lower.Foreground)
+	}
+}
+
+func TestCanvasBrailleColorIsLastDrawn(t *testing.T) {
+	theme := GetTheme("tokyonight")
+	sim := paintCanvas(CanvasBraille, 1, 1, &theme, func(p *CanvasPainter) {
+		p.Point(0, 0, RoleLove)
+		p.Point(1, 3, RoleIris)
+	})
+	if cell, _ := sim.Screen.cellAt(0, 0); cell.Foreground != theme.Palette.Iris {
+		t.Errorf("Expected the cell in the last color drawn, got %v", cell.Foreground)
+	}
+}
+
+func TestCanvasText(t *testing.T) {
+	sim := paintCanvas(CanvasBraille, 8, 2, NewTestTheme(), func(p *CanvasPainter) {
+		p.FillRect(0, 0, 16, 8, RolePrimary)
+		// Pixel 4, 4 is in cell 2, 1
+		p.Text(4, 4, "label", RoleText)
+		// Cut off at both edges
+		p.Text(-4, 0, "abcd", RoleText)
+		p.Text(12, 0, "xyz", RoleText)
+	})
+	if line := sim.GetLine(1); line != "⣿⣿label⣿" {
+		t.Errorf("Expected the label over the pixels, got %q", line)
+	}
+	if line := sim.GetLine(0); line != "cd⣿⣿⣿⣿xy" {
+		t.Errorf("Expected labels clipped at the edges, got %q", line)
+	}
+}
+
+func TestCanvasMeasure(t *testing.T) {
+	canvas := NewCanvas(CanvasBraille)
+	canvas.SetSize(30, 8)
+	if w, h := canvas.Measure(100, 5); w != 30 || h != 5 {
+		t.Errorf("Expected 30x5, got %dx%d", w, h)
+	}
+}
@@ -0,0 +1,259 @@
+package tui
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// seriesRoles are the colors given to series and bars that don't set one,
+// in order
+var seriesRoles = []ColorRole{RolePrimary, RoleFoam, RoleGold, RoleLove, RoleIris, RolePine, RoleRose}
+
+// lowerBlocks are the blocks for 1/8 to 8/8 of a cell, filled from the bottom
+var lowerBlocks = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// Series is a named run of values kept in a fixed-size ring buffer
+//
+// Pushing to a full series drops the oldest value, so a chart of a stream
+// only ever holds its most recent window.
+type Series struct {
+	name   string
+	role   ColorRole
+	values []float64
+	head   int // index of the oldest value
+	count  int
+}
+
+// NewSeries creates an empty series holding up to capacity values
+func NewSeries(name string, capacity int) *Series {
+	return &Series{
+		name:   name,
+		values: make([]float64, max(1, capacity)),
+	}
+}
+
+// Name returns the name shown in chart legends
+func (s *Series) Name() string {
+	return s.name
+}
+
+// SetRole sets the palette role the series is drawn in
+// Without one, charts pick a color by the series' position.
+func (s *Series) SetRole(role ColorRole) {
+	s.role = role
+}
+
+// Role returns the palette role the series is drawn in, or "" if unset
+func (s *Series) Role() ColorRole {
+	return s.role
+}
+
+// Push appends values, dropping the oldest ones once the series is full
+func (s *Series) Push(values ...float64) {
+	for _, value := range values {
+		if s.count < len(s.values) {
+			s.values[(s.head+s.count)%len(s.values)] = value
+			s.count++
+			continue
+		}
+		s.values[s.head] = value
+		s.head = (s.head + 1) % len(s.values)
+	}
+}
+
+// Values returns the values held, oldest first
+func (s *Series) Values() []float64 {
+	values := make([]float64, s.count)
+	for i := range values {
+		values[i] = s.values[(s.head+i)%len(s.values)]
+	}
+	return values
+}
+
+// Last returns the most recent value, or 0 when empty
+func (s *Series) Last() float64 {
+	if s.count == 0 {
+		return 0
+	}
+	return s.values[(s.head+s.count-1)%len(s.values)]
+}
+
+// Len returns how many values are held
+func (s *Series) Len() int {
+	return s.count
+}
+
+// Capacity returns how many values the series holds before dropping old ones
+func (s *Series) Capacity() int {
+	return len(s.values)
+}
+
+// SetCapacity resizes the buffer, keeping the most recent values
+func (s *Series) SetCapacity(capacity int) {
+	values := s.Values()
+	s.values = make([]float64, max(1, capacity))
+	s.head, s.count = 0, 0
+	s.Push(values[max(0, len(values)-len(s.values)):]...)
+}
+
+// Clear removes all values
+func (s *Series) Clear() {
+	s.head, s.count = 0, 0
+}
+
+// seriesRole returns the role a series is drawn in at position i
+func seriesRole(role ColorRole, i int) ColorRole {
+	if role != "" {
+		return role
+	}
+	return seriesRoles[i%len(seriesRoles)]
+}
+
+// chartScale maps values onto a chart's vertical range
+// The range is fixed with SetRange or worked out from the data.
+type chartScale struct {
+	low, high float64
+	fixed     bool
+}
+
+// bounds returns the range to draw values in
+// includeZero keeps the baseline in view for bars.
+func (c chartScale) bounds(values []float64, includeZero bool) (low, high float64) {
+	if c.fixed {
+		return c.low, c.high
+	}
+	low, high = math.Inf(1), math.Inf(-1)
+	if includeZero {
+		low, high = 0, 0
+	}
+	for _, value := range values {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			continue
+		}
+		low, high = math.Min(low, value), math.Max(high, value)
+	}
+	if math.IsInf(low, 1) {
+		return 0, 1
+	}
+	if includeZero && low == 0 && high == 0 {
+		// Bars of nothing but zeros stay empty
+		return 0, 1
+	}
+	if low == high {
+		// A flat line sits in the middle rather than on an edge
+		return low - 1, high + 1
+	}
+	return low, high
+}
+
+// fraction returns where value falls between low and high, from 0 to 1
+func fraction(value, low, high float64) float64 {
+	if high <= low || math.IsNaN(value) {
+		return 0
+	}
+	return math.Max(0, math.Min(1, (value-low)/(high-low)))
+}
+
+// FormatChartValue writes a value compactly for axis labels, like "42",
+// "0.25" or "1.5k"
+func FormatChartValue(value float64) string {
+	suffix := ""
+	switch abs := math.Abs(value); {
+	case abs >= 1e9:
+		value, suffix = value/1e9, "G"
+	case abs >= 1e6:
+		value, suffix = value/1e6, "M"
+	case abs >= 1e3:
+		value, suffix = value/1e3, "k"
+	}
+	text := fmt.Sprintf("%.2f", value)
+	if suffix != "" {
+		text = fmt.Sprintf("%.1f", value)
+	}
+	text = strings.TrimRight(strings.TrimRight(text, "0"), ".")
+	if text == "-0" {
+		text = "0"
+	}
+	return text + suffix
+}
+
+// drawColumn draws a bar rising from the bottom row with eighth-of-a-cell
+// precision
+func drawColumn(screen *Screen, x, bottom, width, height int, fraction float64, style lipgloss.Style) {
+	eighths := int(math.Round(fraction * float64(height*8)))
+	for row := 0; row < height && eighths > 0; row++ {
+		block := lowerBlocks[min(eighths, 8)-1]
+		for col := 0; col < width; col++ {
+			screen.DrawRune(x+col, bottom-row, block, style)
+		}
+		eighths -= 8
+	}
+}
+
+// Sparkline draws a series as a compact strip of bars, newest on the right
+//
+// Each value is one column; with more values than columns only the most
+// recent are shown. Taller sparklines stack blocks for finer resolution.
+type Sparkline struct {
+	series *Series
+	scale  chartScale
+}
+
+// NewSparkline creates a sparkline holding up to capacity values
+func NewSparkline(capacity int) *Sparkline {
+	return &Sparkline{series: NewSeries("", capacity)}
+}
+
+// Series returns the values drawn, for pushing to directly
+func (s *Sparkline) Series() *Series {
+	return s.series
+}
+
+// Push appends values, dropping the oldest ones once full
+func (s *Sparkline) Push(values ...float64) {
+	s.series.Push(values...)
+}
+
+// SetColor sets the palette role the bars are drawn in
+func (s *Sparkline) SetColor(role ColorRole) {
+	s.series.SetRole(role)
+}
+
+// SetRange fixes the values at the bottom and top of the sparkline
+func (s *Sparkline) SetRange(low, high float64) {
+	s.scale = chartScale{low: low, high: high, fixed: true}
+}
+
+// SetAutoRange scales the sparkline to the values shown, the default
+func (s *Sparkline) SetAutoRange() {
+	s.scale = chartScale{}
+}
+
+// Measure returns one column per value the series can hold and one row
+func (s *Sparkline) Measure(maxWidth, maxHeight int) (width, height int) {
+	return min(s.series.Capacity(), maxWidth), min(1, maxHeight)
+}
+
+// Draw renders the most recent values that fit
+func (s *Sparkline) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	if availableWidth <= 0 || availableHeight <= 0 {
+		return
+	}
+	values := s.series.Values()
+	values = values[max(0, len(values)-availableWidth):]
+	// Autoscaling starts from zero, so the smallest value still shows a bar
+	low, high := s.scale.bounds(values, true)
+	style := lipgloss.NewStyle().Foreground(seriesRole(s.series.role, 0).Color(theme.Palette))
+
+	left := x + availableWidth - len(values)
+	bottom := y + availableHeight - 1
+	for i, value := range values {
+		drawColumn(screen, left+i, bottom, 1, availableHeight, fraction(value, low, high), style)
+	}
+}
+
+// HandleInput does nothing; sparklines don't take input
+func (s *Sparkline) HandleInput(key string) {}
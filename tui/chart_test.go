@@ -0,0 +1,278 @@
+package tui
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestSeriesRingBuffer(t *testing.T) {
+	series := NewSeries("cpu", 3)
+	if series.Last() != 0 || len(series.Values()) != 0 {
+		t.Error("Expected an empty series")
+	}
+
+	series.Push(1, 2)
+	if got := series.Values(); !reflect.DeepEqual(got, []float64{1, 2}) {
+		t.Errorf("Expected [1 2], got %v", got)
+	}
+
+	series.Push(3, 4, 5)
+	if got := series.Values(); !reflect.DeepEqual(got, []float64{3, 4, 5}) {
+		t.Errorf("Expected the oldest values dropped, got %v", got)
+	}
+	if series.Last() != 5 || series.Len() != 3 {
+		t.Errorf("Expected last 5 and length 3, got %v and %d", series.Last(), series.Len())
+	}
+
+	series.SetCapacity(2)
+	if got := series.Values(); !reflect.DeepEqual(got, []float64{4, 5}) {
+		t.Errorf("Expected shrinking to keep the newest values, got %v", got)
+	}
+	series.SetCapacity(4)
+	series.Push(6)
+	if got := series.Values(); !reflect.DeepEqual(got, []float64{4, 5, 6}) {
+		t.Errorf("Expected growing to keep the values, got %v", got)
+	}
+
+	series.Clear()
+	if series.Len() != 0 {
+		t.Error("Expected Clear to empty the series")
+	}
+}
+
+func TestChartScaleBounds(t *testing.T) {
+	tests := []struct {
+		name        string
+		scale       chartScale
+		values      []float64
+		includeZero bool
+		low, high   float64
+	}{
+		{"auto", chartScale{}, []float64{3, 7, 5}, false, 3, 7},
+		{"include zero", chartScale{}, []float64{3, 7}, true, 0, 7},
+		{"flat", chartScale{}, []float64{4, 4}, false, 3, 5},
+		{"all zero bars", chartScale{}, []float64{0, 0}, true, 0, 1},
+		{"empty", chartScale{}, nil, false, 0, 1},
+		{"skips NaN", chartScale{}, []float64{math.NaN(), 2, 6}, false, 2, 6},
+		{"fixed", chartScale{low: 0, high: 100, fixed: true}, []float64{3, 7}, false, 0, 100},
+	}
+	for _, tt := range tests {
+		low, high := tt.scale.bounds(tt.values, tt.includeZero)
+		if low != tt.low || high != tt.high {
+			t.Errorf("%s: expected %v..%v, got %v..%v", tt.name, tt.low, tt.high, low, high)
+		}
+	}
+}
+
+func TestFormatChartValue(t *testing.T) {
+	tests := map[float64]string{
+		0:        "0",
+		42:       "42",
+		0.25:     "0.25",
+		3.10:     "3.1",
+		-7:       "-7",
+		1500:     "1.5k",
+		2000000:  "2M",
+		-1250000: "-1.2M",
+		3.2e9:    "3.2G",
+	}
+	for value, expected := range tests {
+		if got := FormatChartValue(value); got != expected {
+			t.Errorf("FormatChartValue(%v): expected %q, got %q", value, expected, got)
+		}
+	}
+}
+
+func TestSparklineDrawsNewestOnTheRight(t *testing.T) {
+	spark := NewSparkline(10)
+	spark.Push(0, 2, 4, 6, 8)
+
+	sim := NewScreenSimulation(4, 1)
+	spark.Draw(sim.Screen, 0, 0, 4, 1, NewTestTheme())
+	if line := sim.GetLine(0); line != "▂▄▆█" {
+		t.Errorf("Expected the four newest values, got %q", line)
+	}
+
+	// Only three values right-align, leaving the first column empty
+	spark = NewSparkline(10)
+	spark.SetRange(0, 8)
+	spark.Push(8, 4, 1)
+	sim = NewScreenSimulation(4, 1)
+	spark.Draw(sim.Screen, 0, 0, 4, 1, NewTestTheme())
+	if line := sim.GetLine(0); line != " █▄▁" {
+		t.Errorf("Expected right-aligned bars, got %q", line)
+	}
+}
+
+func TestSparklineStacksRows(t *testing.T) {
+	spark := NewSparkline(2)
+	spark.SetRange(0, 16)
+	spark.Push(16, 12)
+
+	sim := NewScreenSimulation(2, 2)
+	spark.Draw(sim.Screen, 0, 0, 2, 2, NewTestTheme())
+	if top, bottom := sim.GetLine(0), sim.GetLine(1); top != "█▄" || bottom != "██" {
+		t.Errorf("Expected a two-row sparkline, got %q over %q", top, bottom)
+	}
+}
+
+func TestBarChartHorizontal(t *testing.T) {
+	chart := NewBarChart(
+		Bar{Label: "api", Value: 10},
+		Bar{Label: "db", Value: 5},
+	)
+	chart.SetHorizontal(true)
+	chart.SetValue("cache", 2.5)
+
+	sim := NewScreenSimulation(20, 3)
+	chart.Draw(sim.Screen, 0, 0, 20, 3, NewTestTheme())
+
+	// Labels take 5 columns plus a space, values 4 plus a space
+	expected := []string{
+		"api   ██████████ 10",
+		"db    █████      5",
+		"cache ██▌        2.5",
+	}
+	for i, want := range expected {
+		if line := sim.GetLine(i); line != want {
+			t.Errorf("Row %d: expected %q, got %q", i, want, line)
+		}
+	}
+}
+
+func TestBarChartVertical(t *testing.T) {
+	chart := NewBarChart(
+		Bar{Label: "a", Value: 4},
+		Bar{Label: "b", Value: 2, Role: RoleLove},
+	)
+	chart.SetBarWidth(1)
+	chart.SetShowValues(false)
+	// The monochrome test theme would hide the colors
+	theme := GetTheme("tokyonight")
+
+	sim := NewScreenSimulation(6, 4)
+	chart.Draw(sim.Screen, 0, 0, 6, 4, &theme)
+
+	expected := []string{
+		"4┤ █",
+		"0┤ █ █",
+		" └────",
+		"   a b",
+	}
+	for i, want := range expected {
+		if line := sim.GetLine(i); line != want {
+			t.Errorf("Row %d: expected %q, got %q", i, want, line)
+		}
+	}
+
+	// Bars without a role take the series colors in order
+	first, _ := sim.Screen.cellAt(3, 1)
+	second, _ := sim.Screen.cellAt(5, 1)
+	if first.Foreground != theme.Palette.Primary {
+		t.Error("Expected the first bar in the primary color")
+	}
+	if second.Foreground != theme.Palette.Love {
+		t.Error("Expected the second bar in its own role")
+	}
+}
+
+func TestBarChartVerticalValues(t *testing.T) {
+	chart := NewBarChart(Bar{Label: "a", Value: 5}, Bar{Label: "b", Value: 10})
+	chart.SetRange(0, 20)
+
+	sim := NewScreenSimulation(12, 6)
+	chart.Draw(sim.Screen, 0, 0, 12, 6, NewTestTheme())
+
+	// With four plot rows, 10 of 20 fills two rows and its value sits above
+	AssertTextExists(t, sim, "10")
+	if line := sim.GetLine(1); !strings.Contains(line, "10") {
+		t.Errorf("Expected the value above the bar, got %q", line)
+	}
+	if line := sim.GetLine(2); !strings.Contains(line, "5") {
+		t.Errorf("Expected the smaller value lower down, got %q", line)
+	}
+}
+
+func TestLineChartBraille(t *testing.T) {
+	series := NewSeries("", 8)
+	series.Push(0, 1, 2, 3)
+	chart := NewLineChart(series)
+	chart.SetShowAxes(false)
+	chart.SetRange(0, 3)
+
+	sim := NewScreenSimulation(2, 1)
+	chart.Draw(sim.Screen, 0, 0, 2, 1, NewTestTheme())
+
+	// One dot column per value climbing from the bottom left to the top
+	// right, each column filled up to the previous point
+	left := rune(0x2800 | 0x40 | 0x20 | 0x80)
+	right := rune(0x2800 | 0x02 | 0x04 | 0x08 | 0x10)
+	AssertCellRune(t, sim, 0, 0, left)
+	AssertCellRune(t, sim, 1, 0, right)
+}
+
+func TestLineChartAxesAndLegend(t *testing.T) {
+	rx := NewSeries("rx", 100)
+	tx := NewSeries("tx", 100)
+	tx.SetRole(RoleGold)
+	for i := 0; i < 40; i++ {
+		rx.Push(float64(i))
+		tx.Push(float64(40 - i))
+	}
+	chart := NewLineChart(rx, tx)
+	chart.SetTimeLabels("-40s", "now")
+	theme := GetTheme("tokyonight")
+
+	sim := NewScreenSimulation(30, 8)
+	chart.Draw(sim.Screen, 0, 0, 30, 8, &theme)
+
+	if line := sim.GetLine(0); line != "● rx  ● tx" {
+		t.Errorf("Expected a legend, got %q", line)
+	}
+	if cell, _ := sim.Screen.cellAt(6, 0); cell.Foreground != theme.Palette.Gold {
+		t.Error("Expected the legend dot in the series color")
+	}
+	if line := sim.GetLine(1); !strings.HasPrefix(line, "40┤") {
+		t.Errorf("Expected the top of the range on the axis, got %q", line)
+	}
+	if line := sim.GetLine(6); !strings.HasPrefix(line, " 0┤") {
+		t.Errorf("Expected the bottom of the range on the axis, got %q", line)
+	}
+	baseline := sim.GetLine(7)
+	if !strings.HasPrefix(baseline, "  └─ -40s ") || !strings.HasSuffix(baseline, " now ─") {
+		t.Errorf("Expected a baseline with time labels, got %q", baseline)
+	}
+
+	// Something is plotted in each series color
+	colors := map[lipgloss.TerminalColor]bool{}
+	for y := 1; y < 7; y++ {
+		for x := 3; x < 30; x++ {
+			cell, _ := sim.Screen.cellAt(x, y)
+			if cell.Rune >= 0x2800 && cell.Rune <= 0x28ff {
+				colors[cell.Foreground] = true
+			}
+		}
+	}
+	if len(colors) != 2 {
+		t.Errorf("Expected both series plotted in their own colors, got %v", colors)
+	}
+}
+
+func TestLineChartBreaksOnNaN(t *testing.T) {
+	series := NewSeries("", 4)
+	series.Push(1, math.NaN(), math.NaN(), 1)
+	chart := NewLineChart(series)
+	chart.SetShowAxes(false)
+	chart.SetRange(0, 2)
+
+	sim := NewScreenSimulation(2, 1)
+	chart.Draw(sim.Screen, 0, 0, 2, 1, NewTestTheme())
+
+	// Only the two real points are drawn, in the middle row of dots
+	AssertCellRune(t, sim, 0, 0, rune(0x2800+0x04))
+	AssertCellRune(t, sim, 1, 0, rune(0x2800+0x20))
+}
@@ -0,0 +1,483 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Command is an entry in a CommandPalette
+type Command struct {
+	ID     string // Identifies the command in CommandMsg; defaults to Title
+	Title  string
+	Group  string // Commands are listed under their group when nothing is typed
+	Action string // Keymap action whose keys are shown as a hint
+	Keys   string // Key hint shown instead of the keymap's
+	Run    func()
+}
+
+// CommandMsg is the bubbletea message sent when a command runs
+// See CommandPalette.Update.
+type CommandMsg struct {
+	Command Command
+}
+
+// paletteRow is a row of the result list: a group header or a command
+type paletteRow struct {
+	header  string
+	command int // Index into commands, or -1 for headers
+	match   FuzzyMatch
+}
+
+// CommandPalette is a searchable list of commands in a modal
+//
+// Typing filters the commands with fuzzy matching, best matches first, and
+// highlights the matched characters. With nothing typed the commands are
+// listed by group. Key hints come from the keymaps given to SetKeymaps.
+type CommandPalette struct {
+	modal      *Modal
+	container  *Container
+	input      *Input
+	commands   []Command
+	keymaps    []*Keymap
+	rows       []paletteRow
+	selected   int // Index into rows; always a command row when any match
+	offset     int // First visible row
+	maxResults int
+	onRun      func(Command)
+	ran        *Command
+	keymap     *Keymap
+	focused    bool
+}
+
+// NewCommandPalette creates an empty command palette
+func NewCommandPalette() *CommandPalette {
+	p := &CommandPalette{
+		input:      NewInput(),
+		maxResults: 10,
+		keymap:     DefaultCommandPaletteKeymap(),
+	}
+	p.input.SetPlaceholder("Type a command...")
+
+	p.container = NewContainer()
+	p.container.SetTitle("Commands")
+	p.container.SetPadding(NewMarginTB(0, 1))
+	p.container.SetContent(&paletteBody{palette: p})
+
+	p.modal = NewModal()
+	p.modal.SetContent(p.container)
+	p.modal.SetSizeConstraints(
+		NewConstraintSet(NewPercentage(0.6)).WithMin(40).WithMax(80),
+		NewConstraintSet(NewAuto()),
+	)
+	p.modal.SetDimBackground(true)
+	return p
+}
+
+// DefaultCommandPaletteKeymap returns the default key bindings of a
+// CommandPalette
+// Keys not bound here are typed into the search input.
+func DefaultCommandPaletteKeymap() *Keymap {
+	return NewKeymap("command-palette",
+		KeyBinding{Action: "up", Keys: []string{"up", "ctrl+p", "shift+tab"}, Help: "previous"},
+		KeyBinding{Action: "down", Keys: []string{"down", "ctrl+n", "tab"}, Help: "next"},
+		KeyBinding{Action: "run", Keys: []string{"enter"}, Help: "run"},
+		KeyBinding{Action: "close", Keys: []string{"esc"}, Help: "close"},
+	)
+}
+
+// Keymap returns the palette's key bindings
+func (p *CommandPalette) Keymap() *Keymap {
+	return p.keymap
+}
+
+// SetKeymap sets the palette's key bindings
+func (p *CommandPalette) SetKeymap(keymap *Keymap) {
+	p.keymap = keymap
+}
+
+// SetKeymaps sets the keymaps that key hints are looked up in
+func (p *CommandPalette) SetKeymaps(keymaps ...*Keymap) {
+	p.keymaps = keymaps
+}
+
+// SetTitle sets the title shown on the palette's border
+func (p *CommandPalette) SetTitle(title string) {
+	p.container.SetTitle(title)
+}
+
+// SetMaxResults sets how many rows are shown before the list scrolls
+func (p *CommandPalette) SetMaxResults(n int) {
+	p.maxResults = max(1, n)
+}
+
+// AddCommand adds a command
+func (p *CommandPalette) AddCommand(command Command) {
+	p.commands = append(p.commands, command)
+	p.filter()
+}
+
+// SetCommands replaces all commands
+func (p *CommandPalette) SetCommands(commands []Command) {
+	p.commands = append([]Command(nil), commands...)
+	p.filter()
+}
+
+// OnRun sets the function called when a command runs, after its own Run
+func (p *CommandPalette) OnRun(handler func(Command)) {
+	p.onRun = handler
+}
+
+// Modal returns the modal the palette is drawn in
+func (p *CommandPalette) Modal() *Modal {
+	return p.modal
+}
+
+// Input returns the search input
+func (p *CommandPalette) Input() *Input {
+	return p.input
+}
+
+// Query returns the search text
+func (p *CommandPalette) Query() string {
+	return p.input.Value()
+}
+
+// SetQuery sets the search text and filters the commands
+func (p *CommandPalette) SetQuery(query string) {
+	p.input.SetValue(query)
+	p.filter()
+}
+
+// Results returns the commands matching the search, in display order
+func (p *CommandPalette) Results() []Command {
+	var results []Command
+	for _, row := range p.rows {
+		if row.command >= 0 {
+			results = append(results, p.commands[row.command])
+		}
+	}
+	return results
+}
+
+// Selected returns the highlighted command, if any
+func (p *CommandPalette) Selected() (Command, bool) {
+	if p.selected < len(p.rows) && p.rows[p.selected].command >= 0 {
+		return p.commands[p.rows[p.selected].command], true
+	}
+	return Command{}, false
+}
+
+// Show opens the palette with an empty search
+func (p *CommandPalette) Show() {
+	p.ran = nil
+	p.input.SetValue("")
+	p.input.Focus()
+	p.filter()
+	p.modal.Show()
+}
+
+// Hide closes the palette
+func (p *CommandPalette) Hide() {
+	p.modal.Hide()
+}
+
+// Toggle opens or closes the palette
+func (p *CommandPalette) Toggle() {
+	if p.IsVisible() {
+		p.Hide()
+	} else {
+		p.Show()
+	}
+}
+
+// IsVisible returns whether the palette is open
+func (p *CommandPalette) IsVisible() bool {
+	return p.modal.IsVisible()
+}
+
+// filter rebuilds the result rows from the search text
+func (p *CommandPalette) filter() {
+	query := strings.TrimSpace(p.input.Value())
+	p.rows = p.rows[:0]
+	p.offset = 0
+
+	if query == "" {
+		// Group commands, keeping groups in the order they first appear
+		var groups []string
+		members := map[string][]int{}
+		for i, command := range p.commands {
+			if _, ok := members[command.Group]; !ok {
+				groups = append(groups, command.Group)
+			}
+			members[command.Group] = append(members[command.Group], i)
+		}
+		for _, group := range groups {
+			if group != "" {
+				p.rows = append(p.rows, paletteRow{header: group, command: -1})
+			}
+			for _, i := range members[group] {
+				p.rows = append(p.rows, paletteRow{command: i})
+			}
+		}
+	} else {
+		titles := make([]string, len(p.commands))
+		for i, command := range p.commands {
+			titles[i] = command.Title
+		}
+		for _, result := range FuzzyFilter(query, titles) {
+			p.rows = append(p.rows, paletteRow{command: result.Index, match: result.FuzzyMatch})
+		}
+	}
+
+	p.selected = 0
+	p.move(0, 1)
+}
+
+// move moves the selection by delta commands, skipping headers, and
+// clamps it to the list
+func (p *CommandPalette) move(delta, direction int) {
+	if len(p.rows) == 0 {
+		p.selected = 0
+		return
+	}
+	target := p.selected + delta
+	for target >= 0 && target < len(p.rows) && p.rows[target].command < 0 {
+		target += direction
+	}
+	if target < 0 || target >= len(p.rows) {
+		return // Nothing further in that direction
+	}
+	p.selected = target
+}
+
+// Run runs the highlighted command and closes the palette
+// Returns false if no command matches.
+func (p *CommandPalette) Run() bool {
+	command, ok := p.Selected()
+	if !ok {
+		return false
+	}
+	p.ran = &command
+	p.modal.Hide()
+	if command.Run != nil {
+		command.Run()
+	}
+	if p.onRun != nil {
+		p.onRun(command)
+	}
+	return true
+}
+
+// HandleKey processes keyboard input while the palette is open
+// Returns true if the key was handled
+func (p *CommandPalette) HandleKey(key string) bool {
+	if !p.IsVisible() {
+		return false
+	}
+
+	switch p.keymap.Action(key) {
+	case "up":
+		p.move(-1, -1)
+	case "down":
+		p.move(1, 1)
+	case "run":
+		p.Run()
+	case "close":
+		p.Hide()
+	default:
+		before := p.input.Value()
+		p.input.HandleInput(key)
+		if p.input.Value() != before {
+			p.filter()
+		}
+	}
+	return true
+}
+
+// HandleInput processes keyboard input
+func (p *CommandPalette) HandleInput(key string) {
+	p.HandleKey(key)
+}
+
+// Update handles a bubbletea message and returns a command that sends a
+// CommandMsg when a command runs
+func (p *CommandPalette) Update(msg tea.Msg) tea.Cmd {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !p.IsVisible() {
+		return nil
+	}
+
+	p.ran = nil
+	p.HandleKey(keyMsg.String())
+	if p.ran == nil {
+		return nil
+	}
+	commandMsg := CommandMsg{Command: *p.ran}
+	if commandMsg.Command.ID == "" {
+		commandMsg.Command.ID = commandMsg.Command.Title
+	}
+	return func() tea.Msg {
+		return commandMsg
+	}
+}
+
+// keyHint returns the key hint shown next to a command
+func (p *CommandPalette) keyHint(command Command) string {
+	if command.Keys != "" || command.Action == "" {
+		return command.Keys
+	}
+	for _, keymap := range p.keymaps {
+		if keys := keymap.Keys(command.Action); len(keys) > 0 {
+			return strings.Join(keys, "/")
+		}
+	}
+	return ""
+}
+
+// Draw renders the palette centered in the available space
+func (p *CommandPalette) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	p.modal.Draw(screen, x, y, availableWidth, availableHeight, theme)
+}
+
+// Focus gives keyboard focus to this component
+func (p *CommandPalette) Focus() {
+	p.focused = true
+	p.container.Focus()
+}
+
+// Blur removes keyboard focus from this component
+func (p *CommandPalette) Blur() {
+	p.focused = false
+	p.container.Blur()
+}
+
+// IsFocused returns whether this component currently has focus
+func (p *CommandPalette) IsFocused() bool {
+	return p.focused
+}
+
+// Measure returns the palette's size when drawn in the given space
+func (p *CommandPalette) Measure(maxWidth, maxHeight int) (width, height int) {
+	return p.modal.Measure(maxWidth, maxHeight)
+}
+
+// paletteBody draws the search input and the result list
+type paletteBody struct {
+	palette *CommandPalette
+}
+
+// Measure returns the width of the widest command and the height of the
+// input, a separator and the visible results
+func (b *paletteBody) Measure(maxWidth, maxHeight int) (width, height int) {
+	p := b.palette
+	for _, command := range p.commands {
+		width = max(width, StringWidth(command.Title)+StringWidth(p.keyHint(command))+4)
+	}
+	rows := max(1, min(len(p.rows), p.maxResults))
+	return min(width, maxWidth), min(rows+2, maxHeight)
+}
+
+// Draw renders the input, separator and results
+func (b *paletteBody) Draw(screen *Screen, x, y, width, height int, theme *Theme) {
+	p := b.palette
+	if height <= 0 {
+		return
+	}
+	screen.DrawComponent(p.input, x, y, width, 1, theme)
+	if height < 3 {
+		return
+	}
+
+	separatorStyle := lipgloss.NewStyle().
+		Foreground(theme.Palette.Border).
+		Background(theme.Palette.Surface)
+	screen.DrawString(x, y+1, strings.Repeat("─", width), separatorStyle)
+
+	listY, listHeight := y+2, height-2
+	if len(p.rows) == 0 {
+		muted := lipgloss.NewStyle().
+			Foreground(theme.Palette.TextMuted).
+			Background(theme.Palette.Surface).
+			Italic(true)
+		screen.DrawString(x, listY, Truncate("No matching commands", width), muted)
+		return
+	}
+
+	// Keep the selection in view, showing its group header when possible
+	top := p.selected
+	if top > 0 && p.rows[top-1].command < 0 {
+		top--
+	}
+	if top < p.offset {
+		p.offset = top
+	} else if p.selected >= p.offset+listHeight {
+		p.offset = p.selected - listHeight + 1
+	}
+
+	for i := 0; i < listHeight && p.offset+i < len(p.rows); i++ {
+		index := p.offset + i
+		b.drawRow(screen, p.rows[index], index == p.selected, x, listY+i, width, theme)
+	}
+}
+
+// drawRow renders a group header or a command with its matched characters
+// highlighted and its key hint on the right
+func (b *paletteBody) drawRow(screen *Screen, row paletteRow, selected bool, x, y, width int, theme *Theme) {
+	p := b.palette
+	if row.command < 0 {
+		headerStyle := lipgloss.NewStyle().
+			Foreground(theme.Palette.TextMuted).
+			Background(theme.Palette.Surface).
+			Bold(true)
+		screen.DrawString(x, y, FillRight(Truncate(row.header, width), width), headerStyle)
+		return
+	}
+
+	state := theme.Components.Interactive.Normal
+	if selected {
+		state = theme.Components.Interactive.Selected
+	}
+	style := lipgloss.NewStyle().Foreground(state.Text).Background(state.Background)
+	matchStyle := style.Foreground(theme.Palette.Gold).Bold(true)
+	hintStyle := style.Foreground(theme.Palette.TextMuted)
+	if selected {
+		hintStyle = style
+	}
+	screen.DrawString(x, y, strings.Repeat(" ", width), style)
+
+	command := p.commands[row.command]
+	hint := p.keyHint(command)
+	titleWidth := width - 2
+	if hint != "" {
+		titleWidth -= StringWidth(hint) + 1
+	}
+
+	matched := map[int]bool{}
+	for _, position := range row.match.Positions {
+		matched[position] = true
+	}
+	col := x + 2
+	for i, r := range []rune(command.Title) {
+		w := RuneWidth(r)
+		if col+w > x+2+titleWidth {
+			break
+		}
+		if matched[i] {
+			screen.DrawRune(col, y, r, matchStyle)
+		} else {
+			screen.DrawRune(col, y, r, style)
+		}
+		col += w
+	}
+
+	if hint != "" && titleWidth > 0 {
+		screen.DrawString(x+width-StringWidth(hint)-1, y, hint, hintStyle)
+	}
+}
+
+// HandleInput processes keyboard input
+func (b *paletteBody) HandleInput(key string) {
+	b.palette.HandleKey(key)
+}
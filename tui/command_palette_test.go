@@ -0,0 +1,105 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func newTestCommandPalette() *CommandPalette {
+	palette := NewCommandPalette()
+	palette.SetCommands([]Command{
+		{ID: "open", Title: "Open File", Group: "File", Keys: "ctrl+o"},
+		{ID: "save", Title: "Save File", Group: "File", Action: "save"},
+		{ID: "theme", Title: "Toggle Theme", Group: "View"},
+	})
+	palette.SetKeymaps(NewKeymap("editor", KeyBinding{Action: "save", Keys: []string{"ctrl+s"}}))
+	return palette
+}
+
+func TestCommandPaletteFiltersAndRuns(t *testing.T) {
+	var ran []string
+	palette := newTestCommandPalette()
+	palette.OnRun(func(command Command) { ran = append(ran, command.ID) })
+	palette.Show()
+
+	if results := palette.Results(); len(results) != 3 {
+		t.Fatalf("Expected all commands with no query, got %d", len(results))
+	}
+	if selected, _ := palette.Selected(); selected.ID != "open" {
+		t.Errorf("Expected the first command selected past its group header, got %q", selected.ID)
+	}
+
+	for _, key := range []string{"s", "f"} {
+		palette.HandleKey(key)
+	}
+	results := palette.Results()
+	if len(results) != 1 || results[0].ID != "save" {
+		t.Fatalf("Expected only Save File to match sf, got %+v", results)
+	}
+
+	palette.HandleKey("enter")
+	if palette.IsVisible() {
+		t.Error("Expected running a command to close the palette")
+	}
+	if len(ran) != 1 || ran[0] != "save" {
+		t.Errorf("Expected save to run, got %v", ran)
+	}
+}
+
+func TestCommandPaletteNavigationSkipsHeaders(t *testing.T) {
+	palette := newTestCommandPalette()
+	palette.Show()
+
+	palette.HandleKey("down")
+	palette.HandleKey("down") // Past the View header
+	if selected, _ := palette.Selected(); selected.ID != "theme" {
+		t.Errorf("Expected Toggle Theme selected, got %q", selected.ID)
+	}
+	palette.HandleKey("down") // Stays on the last command
+	palette.HandleKey("up")
+	if selected, _ := palette.Selected(); selected.ID != "save" {
+		t.Errorf("Expected Save File selected, got %q", selected.ID)
+	}
+
+	palette.HandleKey("esc")
+	if palette.IsVisible() {
+		t.Error("Expected esc to close the palette")
+	}
+}
+
+func TestCommandPaletteUpdateSendsCommandMsg(t *testing.T) {
+	palette := newTestCommandPalette()
+	palette.Show()
+
+	palette.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	cmd := palette.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Expected a command when a command runs")
+	}
+	msg, ok := cmd().(CommandMsg)
+	if !ok || msg.Command.ID != "theme" {
+		t.Errorf("Expected a CommandMsg for theme, got %#v", cmd())
+	}
+}
+
+func TestCommandPaletteDraw(t *testing.T) {
+	palette := newTestCommandPalette()
+	palette.Show()
+	palette.SetQuery("file")
+
+	sim := NewScreenSimulation(60, 20)
+	palette.Draw(sim.Screen, 0, 0, 60, 20, NewTestTheme())
+
+	AssertTextExists(t, sim, "Commands")
+	AssertTextExists(t, sim, "Open File")
+	AssertTextExists(t, sim, "ctrl+o")
+	AssertTextExists(t, sim, "ctrl+s")
+	AssertTextNotExists(t, sim, "Toggle Theme")
+	AssertTextNotExists(t, sim, "View")
+
+	palette.SetQuery("zzz")
+	sim = NewScreenSimulation(60, 20)
+	palette.Draw(sim.Screen, 0, 0, 60, 20, NewTestTheme())
+	AssertTextExists(t, sim, "No matching commands")
+}
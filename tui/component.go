@@ -20,3 +20,34 @@ type Focusable interface {
 	// IsFocused returns whether this component currently has focus
 	IsFocused() bool
 }
+
+// Measurer is an optional interface for components that can report their
+// preferred size, used by layouts for fit-content sizing
+type Measurer interface {
+	// Measure returns the preferred width and height within the given limits
+	Measure(maxWidth, maxHeight int) (width, height int)
+}
+
+// MeasureComponent returns the preferred size of a component, clamped to the
+// given limits. Components that don't implement Measurer take all the space.
+func MeasureComponent(component Component, maxWidth, maxHeight int) (width, height int) {
+	measurer, ok := component.(Measurer)
+	if !ok {
+		return maxWidth, maxHeight
+	}
+
+	width, height = measurer.Measure(maxWidth, maxHeight)
+	if width > maxWidth {
+		width = maxWidth
+	}
+	if height > maxHeight {
+		height = maxHeight
+	}
+	if width < 0 {
+		width = 0
+	}
+	if height < 0 {
+		height = 0
+	}
+	return width, height
+}
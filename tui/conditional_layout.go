@@ -115,7 +115,7 @@ func (c *Conditional) drawWithBounds(screen *Screen, x, y, width, height int, th
 
 	// Draw the selected component
 	if componentToDraw != nil {
-		componentToDraw.Draw(screen, x, y, width, height, theme)
+		screen.DrawComponent(componentToDraw, x, y, width, height, theme)
 	}
 }
 
@@ -129,6 +129,19 @@ func (c *Conditional) GetActiveComponent() Component {
 	return c.fallback
 }
 
+// Measure returns the preferred size of the component active at the given size
+func (c *Conditional) Measure(maxWidth, maxHeight int) (width, height int) {
+	for _, item := range c.items {
+		if item.Condition(maxWidth, maxHeight) {
+			return MeasureComponent(item.Component, maxWidth, maxHeight)
+		}
+	}
+	if c.fallback != nil {
+		return MeasureComponent(c.fallback, maxWidth, maxHeight)
+	}
+	return 0, 0
+}
+
 // HandleInput processes keyboard input
 func (c *Conditional) HandleInput(key string) {
 	// Conditional doesn't handle input itself
@@ -175,38 +188,35 @@ func (r *ResponsiveLayout) AddTall(component Component) {
 
 // Focus gives keyboard focus to this component
 func (c *Conditional) Focus() {
-	if active := c.GetActiveComponent(); active != nil {
-		if focusable, ok := active.(Focusable); ok {
-			focusable.Focus()
-		}
-	}
+	focusFirst(c.Children())
 }
 
 // Blur removes keyboard focus from this component
 func (c *Conditional) Blur() {
-	if active := c.GetActiveComponent(); active != nil {
-		if focusable, ok := active.(Focusable); ok {
-			focusable.Blur()
-		}
-	}
+	blurAll(c.Children())
 }
 
 // IsFocused returns whether this component currently has focus
 func (c *Conditional) IsFocused() bool {
-	if active := c.GetActiveComponent(); active != nil {
-		if focusable, ok := active.(Focusable); ok {
-			return focusable.IsFocused()
-		}
-	}
-	return false
+	return anyFocused(c.Children())
 }
 
 // HandleKey processes keyboard input when focused
 func (c *Conditional) HandleKey(key string) bool {
+	return handleKeyFocused(c.Children(), key)
+}
+
+// ForwardsFocus reports that the layout only passes focus on to its
+// children
+func (c *Conditional) ForwardsFocus() bool {
+	return true
+}
+
+// Children returns the active component only, so focus skips branches
+// that aren't shown
+func (c *Conditional) Children() []Component {
 	if active := c.GetActiveComponent(); active != nil {
-		if handler, ok := active.(interface{ HandleKey(string) bool }); ok {
-			return handler.HandleKey(key)
-		}
+		return []Component{active}
 	}
-	return false
+	return nil
 }
@@ -0,0 +1,337 @@
+package tui
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Strength is how strongly a constraint's size is held when space runs out.
+// Weaker constraints give up space first; required ones never do.
+type Strength int
+
+const (
+	// StrengthWeak sizes shrink before any others
+	StrengthWeak Strength = -1
+	// StrengthStrong is the default strength
+	StrengthStrong Strength = 0
+	// StrengthRequired sizes never shrink; if they don't fit the layout is unsatisfiable
+	StrengthRequired Strength = 1
+)
+
+// String returns the name of the strength
+func (s Strength) String() string {
+	switch s {
+	case StrengthWeak:
+		return "weak"
+	case StrengthStrong:
+		return "strong"
+	case StrengthRequired:
+		return "required"
+	}
+	return fmt.Sprintf("Strength(%d)", int(s))
+}
+
+// UnsatisfiableError reports required sizes and minimums that exceed the
+// available space
+type UnsatisfiableError struct {
+	Required  int // Cells needed by required sizes and minimums
+	Available int // Cells available
+}
+
+// Error implements the error interface
+func (e *UnsatisfiableError) Error() string {
+	return fmt.Sprintf("tui: layout needs at least %d cells but only %d are available", e.Required, e.Available)
+}
+
+// ConstraintSolution is the result of solving a set of constraints
+type ConstraintSolution struct {
+	Sizes []int // One size per constraint set
+	Slack int   // Space left unused because no item could grow into it
+}
+
+// solverUnit is a single item, or a group of items that must be equal in size
+type solverUnit struct {
+	members  []int
+	size     int // Per-member size
+	min      int // Per-member minimum
+	max      int // Per-member maximum
+	flex     bool
+	weight   float64
+	strength Strength
+}
+
+// cells returns the total space used by the unit
+func (u *solverUnit) cells() int {
+	return u.size * len(u.members)
+}
+
+// SolveConstraints resolves constraint sets against the available space.
+//
+// Lengths, percentages and measured (Auto) sizes are honored first; Ratio
+// items then fill the remaining space in proportion to their ratio. When
+// space runs out, weak sizes shrink before strong ones, and required sizes
+// and minimums never shrink. Items sharing a group get equal sizes.
+//
+// The sizes plus the slack always add up to totalSize, and the slack is zero
+// whenever a Ratio item can still grow. If the required space exceeds
+// totalSize an *UnsatisfiableError is returned along with sizes scaled down
+// to fit.
+func SolveConstraints(constraints []ConstraintSet, totalSize int) (ConstraintSolution, error) {
+	if totalSize < 0 {
+		totalSize = 0
+	}
+	units := buildSolverUnits(constraints, totalSize)
+
+	// Space that can never be given up
+	required := 0
+	for _, u := range units {
+		if u.strength >= StrengthRequired && !u.flex {
+			required += u.cells()
+		} else {
+			required += u.min * len(u.members)
+		}
+	}
+	if required > totalSize {
+		sizes := make([]int, len(constraints))
+		for _, u := range units {
+			for _, member := range u.members {
+				sizes[member] = u.size
+			}
+		}
+		scaleToFit(sizes, totalSize)
+		return ConstraintSolution{Sizes: sizes}, &UnsatisfiableError{Required: required, Available: totalSize}
+	}
+
+	used := 0
+	for _, u := range units {
+		used += u.cells()
+	}
+
+	// Shrink the weakest sizes first until everything fits
+	for _, level := range []Strength{StrengthWeak, StrengthStrong} {
+		if used <= totalSize {
+			break
+		}
+		var candidates []*solverUnit
+		for _, u := range units {
+			if u.strength <= level && u.size > u.min {
+				candidates = append(candidates, u)
+			}
+		}
+		used -= shrinkUnits(candidates, used-totalSize)
+	}
+
+	// Grow flexible items into the remaining space
+	remaining := totalSize - used
+	if remaining > 0 {
+		remaining = growUnits(units, remaining)
+	}
+
+	sizes := make([]int, len(constraints))
+	for _, u := range units {
+		for _, member := range u.members {
+			sizes[member] = u.size
+		}
+	}
+	return ConstraintSolution{Sizes: sizes, Slack: remaining}, nil
+}
+
+// buildSolverUnits groups constraint sets and computes their starting sizes
+func buildSolverUnits(constraints []ConstraintSet, totalSize int) []*solverUnit {
+	var units []*solverUnit
+	groups := map[string]*solverUnit{}
+	// Strength of the fixed member that decided each group's size
+	deciding := map[*solverUnit]Strength{}
+
+	for i, cs := range constraints {
+		minSize, maxSize := 0, math.MaxInt32
+		if cs.Min != nil {
+			minSize = int(cs.Min.Value)
+		}
+		if cs.Max != nil {
+			maxSize = int(cs.Max.Value)
+		}
+		// Like Calculate, the maximum wins over the minimum
+		minSize = min(minSize, maxSize)
+
+		flex := cs.Base.Type == Ratio
+		desired := 0
+		switch cs.Base.Type {
+		case Length, Auto:
+			desired = int(cs.Base.Value)
+		case Percentage:
+			desired = int(float64(totalSize) * cs.Base.Value)
+		}
+
+		u, grouped := groups[cs.Group]
+		if cs.Group == "" || !grouped {
+			u = &solverUnit{
+				min:      minSize,
+				max:      maxSize,
+				flex:     flex,
+				strength: cs.Strength,
+			}
+			if flex {
+				u.weight = cs.Base.Value
+			} else {
+				u.size = desired
+				deciding[u] = cs.Strength
+			}
+			units = append(units, u)
+			if cs.Group != "" {
+				groups[cs.Group] = u
+			}
+		} else {
+			u.min = max(u.min, minSize)
+			u.max = min(u.max, maxSize)
+			u.min = min(u.min, u.max)
+			u.strength = max(u.strength, cs.Strength)
+			if flex {
+				u.weight += cs.Base.Value
+			} else if strength, ok := deciding[u]; !ok || cs.Strength > strength {
+				// The strongest fixed member decides the group's size
+				u.flex = false
+				u.size = desired
+				deciding[u] = cs.Strength
+			}
+		}
+		u.members = append(u.members, i)
+	}
+
+	for _, u := range units {
+		if u.flex {
+			u.size = u.min
+		} else {
+			u.size = max(u.min, min(u.size, u.max))
+		}
+	}
+	return units
+}
+
+// shrinkUnits removes up to excess cells from the units in proportion to how
+// far each is above its minimum, returning the number of cells removed
+func shrinkUnits(units []*solverUnit, excess int) int {
+	capacity := 0
+	for _, u := range units {
+		capacity += (u.size - u.min) * len(u.members)
+	}
+	if capacity == 0 || excess <= 0 {
+		return 0
+	}
+
+	removed := 0
+	for _, u := range units {
+		share := excess * (u.size - u.min) * len(u.members) / capacity
+		step := min(share/len(u.members), u.size-u.min)
+		u.size -= step
+		removed += step * len(u.members)
+	}
+
+	// Hand out the rounding remainder one step at a time, largest units first
+	for removed < excess {
+		var best *solverUnit
+		for _, u := range units {
+			if u.size > u.min && (best == nil || u.size-u.min > best.size-best.min) {
+				best = u
+			}
+		}
+		if best == nil {
+			break
+		}
+		best.size--
+		removed += len(best.members)
+	}
+	return removed
+}
+
+// growUnits distributes the remaining cells among flexible units by weight,
+// respecting their maximums, and returns the cells left over
+func growUnits(units []*solverUnit, remaining int) int {
+	for remaining > 0 {
+		var active []*solverUnit
+		totalWeight := 0.0
+		for _, u := range units {
+			if u.flex && u.weight > 0 && u.size < u.max && len(u.members) <= remaining {
+				active = append(active, u)
+				totalWeight += u.weight
+			}
+		}
+		if len(active) == 0 {
+			break
+		}
+
+		allocated := 0
+		fractions := make([]float64, len(active))
+		for i, u := range active {
+			exact := float64(remaining) * u.weight / totalWeight / float64(len(u.members))
+			step := min(int(exact), u.max-u.size)
+			fractions[i] = exact - float64(int(exact))
+			if step > 0 {
+				u.size += step
+				allocated += step * len(u.members)
+			}
+		}
+		remaining -= allocated
+		if allocated > 0 {
+			continue
+		}
+
+		// Less than one step each: give single steps by largest remainder
+		order := make([]int, len(active))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(a, b int) bool {
+			return fractions[order[a]] > fractions[order[b]]
+		})
+		for _, i := range order {
+			u := active[i]
+			if len(u.members) <= remaining && u.size < u.max {
+				u.size++
+				remaining -= len(u.members)
+				allocated += len(u.members)
+			}
+		}
+		if allocated == 0 {
+			break
+		}
+	}
+	return remaining
+}
+
+// scaleToFit scales sizes down proportionally so they sum to exactly total,
+// using the largest remainder method so the result is deterministic
+func scaleToFit(sizes []int, total int) {
+	sum := 0
+	for _, size := range sizes {
+		sum += size
+	}
+	if sum <= total {
+		return
+	}
+
+	remainders := make([]int, len(sizes))
+	assigned := 0
+	for i, size := range sizes {
+		scaled := size * total
+		sizes[i] = scaled / sum
+		remainders[i] = scaled % sum
+		assigned += sizes[i]
+	}
+
+	order := make([]int, len(sizes))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+	for _, i := range order {
+		if assigned >= total {
+			break
+		}
+		sizes[i]++
+		assigned++
+	}
+}
@@ -0,0 +1,312 @@
+package tui
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestSolveConstraintsStrengths(t *testing.T) {
+	constraints := []ConstraintSet{
+		NewConstraintSet(NewLength(40)).WithStrength(StrengthRequired),
+		NewConstraintSet(NewLength(40)),
+		NewConstraintSet(NewLength(40)).WithStrength(StrengthWeak),
+	}
+
+	// Weak gives up its space first
+	solution, err := SolveConstraints(constraints, 90)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(solution.Sizes, []int{40, 40, 10}) {
+		t.Errorf("Expected [40 40 10], got %v", solution.Sizes)
+	}
+
+	// Then strong, required never shrinks
+	solution, err = SolveConstraints(constraints, 50)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(solution.Sizes, []int{40, 10, 0}) {
+		t.Errorf("Expected [40 10 0], got %v", solution.Sizes)
+	}
+}
+
+func TestSolveConstraintsMinimumsHold(t *testing.T) {
+	constraints := []ConstraintSet{
+		NewConstraintSet(NewLength(30)).WithMin(20),
+		NewConstraintSet(NewLength(30)),
+	}
+
+	// Both shrink in proportion to how far they are above their minimum
+	solution, err := SolveConstraints(constraints, 40)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(solution.Sizes, []int{25, 15}) {
+		t.Errorf("Expected [25 15], got %v", solution.Sizes)
+	}
+
+	solution, err = SolveConstraints(constraints, 20)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(solution.Sizes, []int{20, 0}) {
+		t.Errorf("Expected [20 0], got %v", solution.Sizes)
+	}
+}
+
+func TestSolveConstraintsFillRemaining(t *testing.T) {
+	constraints := []ConstraintSet{
+		NewConstraintSet(NewLength(10)),
+		NewConstraintSet(NewRatio(1)),
+		NewConstraintSet(NewRatio(1)),
+		NewConstraintSet(NewRatio(1)),
+	}
+
+	// 90 cells over three equal ratios can't split evenly; nothing is lost
+	solution, err := SolveConstraints(constraints, 101)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	sum := 0
+	for _, size := range solution.Sizes {
+		sum += size
+	}
+	if sum != 101 || solution.Slack != 0 {
+		t.Errorf("Expected sizes to fill 101 cells, got %v (slack %d)", solution.Sizes, solution.Slack)
+	}
+
+	// A capped ratio leaves the rest to the others
+	constraints[1] = constraints[1].WithMax(5)
+	solution, _ = SolveConstraints(constraints, 101)
+	if solution.Sizes[1] != 5 || solution.Sizes[2]+solution.Sizes[3] != 86 {
+		t.Errorf("Expected capped ratio at 5 and others sharing 86, got %v", solution.Sizes)
+	}
+}
+
+func TestSolveConstraintsEqualGroups(t *testing.T) {
+	constraints := []ConstraintSet{
+		NewConstraintSet(NewRatio(1)).WithGroup("buttons"),
+		NewConstraintSet(NewLength(7)),
+		NewConstraintSet(NewRatio(3)).WithGroup("buttons"),
+	}
+
+	solution, err := SolveConstraints(constraints, 30)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if solution.Sizes[0] != solution.Sizes[2] {
+		t.Errorf("Expected equal group sizes, got %v", solution.Sizes)
+	}
+	// 23 cells can't split into two equal parts; one is left over
+	if solution.Sizes[0] != 11 || solution.Slack != 1 {
+		t.Errorf("Expected 11 each with 1 slack, got %v (slack %d)", solution.Sizes, solution.Slack)
+	}
+
+	// A fixed member decides the group's size
+	constraints[2] = NewConstraintSet(NewLength(4)).WithGroup("buttons")
+	solution, _ = SolveConstraints(constraints, 30)
+	if !reflect.DeepEqual(solution.Sizes, []int{4, 7, 4}) {
+		t.Errorf("Expected [4 7 4], got %v", solution.Sizes)
+	}
+}
+
+func TestSolveConstraintsUnsatisfiable(t *testing.T) {
+	constraints := []ConstraintSet{
+		NewConstraintSet(NewLength(30)).WithStrength(StrengthRequired),
+		NewConstraintSet(NewRatio(1)).WithMin(20),
+		NewConstraintSet(NewLength(10)),
+	}
+
+	solution, err := SolveConstraints(constraints, 40)
+	var unsatisfiable *UnsatisfiableError
+	if !errors.As(err, &unsatisfiable) {
+		t.Fatalf("Expected *UnsatisfiableError, got %v", err)
+	}
+	if unsatisfiable.Required != 50 || unsatisfiable.Available != 40 {
+		t.Errorf("Expected 50 required of 40, got %d of %d", unsatisfiable.Required, unsatisfiable.Available)
+	}
+	if err.Error() != "tui: layout needs at least 50 cells but only 40 are available" {
+		t.Errorf("Unexpected message: %q", err.Error())
+	}
+
+	// The fallback still fits, and is the same every time
+	again, _ := SolveConstraints(constraints, 40)
+	if !reflect.DeepEqual(solution.Sizes, again.Sizes) {
+		t.Errorf("Expected deterministic sizes, got %v and %v", solution.Sizes, again.Sizes)
+	}
+	if !reflect.DeepEqual(solution.Sizes, []int{20, 13, 7}) {
+		t.Errorf("Expected [20 13 7], got %v", solution.Sizes)
+	}
+}
+
+// randomConstraintSet builds an arbitrary constraint set for property tests
+func randomConstraintSet(rng *rand.Rand) ConstraintSet {
+	var cs ConstraintSet
+	switch rng.Intn(4) {
+	case 0:
+		cs = NewConstraintSet(NewLength(rng.Intn(60)))
+	case 1:
+		cs = NewConstraintSet(NewPercentage(rng.Float64()))
+	case 2:
+		cs = NewConstraintSet(NewRatio(float64(rng.Intn(4))))
+	case 3:
+		cs = NewConstraintSet(NewAuto())
+		cs.Base.Value = float64(rng.Intn(30))
+	}
+	if rng.Intn(4) == 0 {
+		cs = cs.WithMin(rng.Intn(20))
+	}
+	if rng.Intn(4) == 0 {
+		cs = cs.WithMax(rng.Intn(40))
+	}
+	cs = cs.WithStrength(Strength(rng.Intn(3) - 1))
+	if rng.Intn(3) == 0 {
+		cs = cs.WithGroup([]string{"a", "b"}[rng.Intn(2)])
+	}
+	return cs
+}
+
+func TestSolveConstraintsProperties(t *testing.T) {
+	rng := rand.New(rand.NewSource(31))
+
+	for iteration := 0; iteration < 2000; iteration++ {
+		constraints := make([]ConstraintSet, 1+rng.Intn(6))
+		for i := range constraints {
+			constraints[i] = randomConstraintSet(rng)
+		}
+		total := rng.Intn(150)
+
+		solution, err := SolveConstraints(constraints, total)
+		if len(solution.Sizes) != len(constraints) {
+			t.Fatalf("Expected %d sizes, got %d", len(constraints), len(solution.Sizes))
+		}
+
+		sum := 0
+		for _, size := range solution.Sizes {
+			if size < 0 {
+				t.Fatalf("Negative size for %v in %d: %v", constraints, total, solution.Sizes)
+			}
+			sum += size
+		}
+		if solution.Slack < 0 || sum+solution.Slack != total {
+			t.Fatalf("Sizes %v plus slack %d don't sum to %d for %v", solution.Sizes, solution.Slack, total, constraints)
+		}
+
+		if err != nil {
+			if solution.Slack != 0 {
+				t.Fatalf("Expected no slack when unsatisfiable, got %d", solution.Slack)
+			}
+			continue
+		}
+
+		// Per group: its size, member count, and whether it can still grow
+		type groupState struct {
+			size, members int
+			fills, weight bool
+		}
+		groups := map[string]*groupState{}
+		for i, cs := range constraints {
+			size := solution.Sizes[i]
+			// A ratio that hasn't reached its maximum could still grow
+			growable := cs.Base.Type == Ratio && (cs.Max == nil || size < int(cs.Max.Value))
+			if cs.Group == "" {
+				// The maximum wins over the minimum
+				if cs.Min != nil && size < int(cs.Min.Value) && (cs.Max == nil || size < int(cs.Max.Value)) {
+					t.Fatalf("Size %d below minimum for %v", size, cs)
+				}
+				if cs.Max != nil && size > int(cs.Max.Value) {
+					t.Fatalf("Size %d above maximum for %v", size, cs)
+				}
+				// So a fill item soaks up all remaining space
+				if growable && cs.Base.Value > 0 && solution.Slack != 0 {
+					t.Fatalf("Expected no slack with %v in %v, got %d", cs, constraints, solution.Slack)
+				}
+				continue
+			}
+			g, ok := groups[cs.Group]
+			if !ok {
+				g = &groupState{size: size, fills: true}
+				groups[cs.Group] = g
+			}
+			if g.size != size {
+				t.Fatalf("Group %q has unequal sizes %v for %v", cs.Group, solution.Sizes, constraints)
+			}
+			g.members++
+			g.fills = g.fills && growable
+			g.weight = g.weight || (cs.Base.Type == Ratio && cs.Base.Value > 0)
+		}
+		// A group of fill items grows a cell per member at a time, so it
+		// leaves less slack than it has members
+		for name, g := range groups {
+			if g.fills && g.weight && solution.Slack >= g.members {
+				t.Fatalf("Expected less than %d slack with group %q in %v, got %d", g.members, name, constraints, solution.Slack)
+			}
+		}
+	}
+}
+
+func TestSolveConstraintsSlack(t *testing.T) {
+	tests := []struct {
+		name        string
+		constraints []ConstraintSet
+		slack       int
+	}{
+		{"fixed sizes leave space unfilled", []ConstraintSet{
+			NewConstraintSet(NewLength(10)),
+			NewConstraintSet(NewAuto()),
+		}, 20},
+		{"a fill item takes the rest", []ConstraintSet{
+			NewConstraintSet(NewLength(10)),
+			NewConstraintSet(NewRatio(1)),
+		}, 0},
+		{"a capped fill item stops at its maximum", []ConstraintSet{
+			NewConstraintSet(NewLength(10)),
+			NewConstraintSet(NewRatio(1)).WithMax(5),
+		}, 15},
+		{"another fill item takes what a capped one can't", []ConstraintSet{
+			NewConstraintSet(NewRatio(1)).WithMax(5),
+			NewConstraintSet(NewRatio(1)),
+		}, 0},
+		{"a zero ratio doesn't fill", []ConstraintSet{
+			NewConstraintSet(NewLength(10)),
+			NewConstraintSet(NewRatio(0)),
+		}, 20},
+		{"a fill group leaves what it can't split evenly", []ConstraintSet{
+			NewConstraintSet(NewLength(9)),
+			NewConstraintSet(NewRatio(1)).WithGroup("a"),
+			NewConstraintSet(NewRatio(1)).WithGroup("a"),
+		}, 1},
+	}
+	for _, tt := range tests {
+		solution, err := SolveConstraints(tt.constraints, 30)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if solution.Slack != tt.slack {
+			t.Errorf("%s: expected slack %d, got %d (sizes %v)", tt.name, tt.slack, solution.Slack, solution.Sizes)
+		}
+	}
+}
+
+func TestLinearLayoutReportsUnsatisfiable(t *testing.T) {
+	screen := NewScreenSimulation(20, 1)
+	theme := NewTestTheme()
+
+	layout := HBox()
+	layout.Add(&mockComponent{id: "a"}, NewConstraintSet(NewLength(15)).WithStrength(StrengthRequired))
+	layout.Add(&mockComponent{id: "b"}, NewConstraintSet(NewLength(15)).WithStrength(StrengthRequired))
+	layout.Draw(screen.Screen, 0, 0, 20, 1, theme)
+
+	var unsatisfiable *UnsatisfiableError
+	if !errors.As(layout.LayoutError(), &unsatisfiable) {
+		t.Fatalf("Expected *UnsatisfiableError, got %v", layout.LayoutError())
+	}
+
+	layout.Draw(screen.Screen, 0, 0, 40, 1, theme)
+	if layout.LayoutError() != nil {
+		t.Errorf("Expected no error with enough space, got %v", layout.LayoutError())
+	}
+}
@@ -1,5 +1,7 @@
 package tui
 
+import "fmt"
+
 // ConstraintType defines the type of constraint
 type ConstraintType int
 
@@ -14,6 +16,8 @@ const (
 	Min
 	// Max is a maximum size constraint
 	Max
+	// Auto sizes to the component's measured size (see Measurer)
+	Auto
 )
 
 // Constraint represents a size constraint for layouts
@@ -61,11 +65,19 @@ func NewMax(max int) Constraint {
 	return Constraint{Type: Max, Value: float64(max)}
 }
 
+// NewAuto creates a fit-content constraint
+// Layouts replace it with the component's measured size before solving
+func NewAuto() Constraint {
+	return Constraint{Type: Auto}
+}
+
 // ConstraintSet combines multiple constraints for a single dimension
 type ConstraintSet struct {
-	Base Constraint  // Primary constraint (Length, Percentage, or Ratio)
-	Min  *Constraint // Optional minimum
-	Max  *Constraint // Optional maximum
+	Base     Constraint  // Primary constraint (Length, Percentage, or Ratio)
+	Min      *Constraint // Optional minimum
+	Max      *Constraint // Optional maximum
+	Strength Strength    // How strongly the size is held when space runs out
+	Group    string      // Items sharing a group get equal sizes
 }
 
 // NewConstraintSet creates a constraint set with just a base constraint
@@ -87,12 +99,70 @@ func (cs ConstraintSet) WithMax(max int) ConstraintSet {
 	return cs
 }
 
+// WithStrength sets how strongly the size is held when space runs out
+func (cs ConstraintSet) WithStrength(strength Strength) ConstraintSet {
+	cs.Strength = strength
+	return cs
+}
+
+// WithGroup puts the item in an equal-size group
+// Every item in a layout with the same group name gets the same size.
+func (cs ConstraintSet) WithGroup(group string) ConstraintSet {
+	cs.Group = group
+	return cs
+}
+
+// String describes the constraint, e.g. "Length(3)" or "Percentage(50%)"
+func (c Constraint) String() string {
+	switch c.Type {
+	case Length:
+		return fmt.Sprintf("Length(%d)", int(c.Value))
+	case Percentage:
+		return fmt.Sprintf("Percentage(%g%%)", c.Value*100)
+	case Ratio:
+		return fmt.Sprintf("Ratio(%g)", c.Value)
+	case Min:
+		return fmt.Sprintf("Min(%d)", int(c.Value))
+	case Max:
+		return fmt.Sprintf("Max(%d)", int(c.Value))
+	case Auto:
+		return "Auto"
+	}
+	return fmt.Sprintf("Constraint(%d, %g)", c.Type, c.Value)
+}
+
+// String describes the constraint set, e.g. "Ratio(1) min=10 max=40"
+func (cs ConstraintSet) String() string {
+	result := cs.Base.String()
+	if cs.Min != nil {
+		result += fmt.Sprintf(" min=%d", int(cs.Min.Value))
+	}
+	if cs.Max != nil {
+		result += fmt.Sprintf(" max=%d", int(cs.Max.Value))
+	}
+	if cs.Strength != StrengthStrong {
+		result += " " + cs.Strength.String()
+	}
+	if cs.Group != "" {
+		result += " group=" + cs.Group
+	}
+	return result
+}
+
+// resolveAuto replaces an Auto base constraint with a measured length
+func (cs ConstraintSet) resolveAuto(measured int) ConstraintSet {
+	if cs.Base.Type == Auto {
+		cs.Base = NewLength(measured)
+	}
+	return cs
+}
+
 // Calculate computes the actual size given a parent size
 func (cs ConstraintSet) Calculate(parentSize int, ratioTotal float64) int {
 	var size int
 
 	switch cs.Base.Type {
-	case Length:
+	case Length, Auto:
 		size = int(cs.Base.Value)
 	case Percentage:
 		size = int(float64(parentSize) * cs.Base.Value)
@@ -114,52 +184,11 @@ func (cs ConstraintSet) Calculate(parentSize int, ratioTotal float64) int {
 }
 
 // CalculateConstraints calculates sizes for multiple constraint sets
+// See SolveConstraints for how conflicts are resolved; when the layout is
+// unsatisfiable the sizes are scaled down to fit.
 func CalculateConstraints(constraints []ConstraintSet, totalSize int) []int {
-	sizes := make([]int, len(constraints))
-
-	// First pass: calculate fixed and percentage constraints
-	remainingSize := totalSize
-	ratioTotal := 0.0
-	ratioIndices := []int{}
-
-	for i, cs := range constraints {
-		switch cs.Base.Type {
-		case Length:
-			size := cs.Calculate(totalSize, 0)
-			sizes[i] = size
-			remainingSize -= size
-		case Percentage:
-			size := cs.Calculate(totalSize, 0)
-			sizes[i] = size
-			remainingSize -= size
-		case Ratio:
-			ratioTotal += cs.Base.Value
-			ratioIndices = append(ratioIndices, i)
-		}
-	}
-
-	// Second pass: distribute remaining size among ratio constraints
-	if len(ratioIndices) > 0 && remainingSize > 0 {
-		for _, i := range ratioIndices {
-			sizes[i] = constraints[i].Calculate(remainingSize, ratioTotal)
-		}
-	}
-
-	// Third pass: ensure we don't exceed total size
-	totalUsed := 0
-	for _, size := range sizes {
-		totalUsed += size
-	}
-
-	// If we've exceeded, scale down proportionally
-	if totalUsed > totalSize {
-		scale := float64(totalSize) / float64(totalUsed)
-		for i := range sizes {
-			sizes[i] = int(float64(sizes[i]) * scale)
-		}
-	}
-
-	return sizes
+	solution, _ := SolveConstraints(constraints, totalSize)
+	return solution.Sizes
 }
 
 // Direction represents layout direction
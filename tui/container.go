@@ -113,7 +113,7 @@ func (c *Container) draw(screen *Screen, x, y, width, height int, theme *Theme)
 	// Draw border if enabled
 	if c.showBorder {
 		borderColor := theme.Palette.Border
-		if c.focused {
+		if c.hasFocus() {
 			borderColor = theme.Palette.Primary
 		}
 
@@ -125,7 +125,7 @@ func (c *Container) draw(screen *Screen, x, y, width, height int, theme *Theme)
 		case "double":
 			c.drawDoubleBorder(screen, x, y, width, height, borderStyle, theme)
 		case "heavy", "bold":
-			if c.focused {
+			if c.hasFocus() {
 				c.drawHeavyBorder(screen, x, y, width, height, borderStyle, theme)
 			} else {
 				c.drawSingleBorder(screen, x, y, width, height, borderStyle, theme)
@@ -151,7 +151,7 @@ func (c *Container) draw(screen *Screen, x, y, width, height int, theme *Theme)
 
 	// Draw content if it fits
 	if contentWidth > 0 && contentHeight > 0 && c.content != nil {
-		c.content.Draw(screen, contentX, contentY, contentWidth, contentHeight, theme)
+		screen.DrawComponent(c.content, contentX, contentY, contentWidth, contentHeight, theme)
 	}
 }
 
@@ -189,7 +189,7 @@ func (c *Container) drawHorizontalBorderWithElements(screen *Screen, x, y, width
 	for _, elem := range leftElements {
 		currentX += elem.Offset
 		if currentX+elem.Element.Width() < x+width-1 {
-			width := elem.Element.Draw(screen, currentX, y, theme, c.focused)
+			width := elem.Element.Draw(screen, currentX, y, theme, c.hasFocus())
 			currentX += width
 		}
 	}
@@ -199,7 +199,7 @@ func (c *Container) drawHorizontalBorderWithElements(screen *Screen, x, y, width
 		elemWidth := elem.Element.Width()
 		centerX := x + (width-elemWidth)/2 + elem.Offset
 		if centerX > x && centerX+elemWidth < x+width-1 {
-			elem.Element.Draw(screen, centerX, y, theme, c.focused)
+			elem.Element.Draw(screen, centerX, y, theme, c.hasFocus())
 		}
 	}
 
@@ -209,7 +209,7 @@ func (c *Container) drawHorizontalBorderWithElements(screen *Screen, x, y, width
 		elemWidth := elem.Element.Width()
 		rightX := x + width - 1 - elemWidth - elem.Offset
 		if rightX > x {
-			elem.Element.Draw(screen, rightX, y, theme, c.focused)
+			elem.Element.Draw(screen, rightX, y, theme, c.hasFocus())
 		}
 	}
 }
@@ -330,6 +330,26 @@ func (c *Container) IsFocused() bool {
 	return c.focused
 }
 
+// hasFocus returns whether the container or anything inside it has focus
+// A focus tree focuses the content directly, so the border follows it too.
+func (c *Container) hasFocus() bool {
+	return c.focused || anyFocused(c.Children())
+}
+
+// ForwardsFocus reports that the container only passes focus on to its
+// children
+func (c *Container) ForwardsFocus() bool {
+	return true
+}
+
+// Children returns the container's content
+func (c *Container) Children() []Component {
+	if c.content != nil {
+		return []Component{c.content}
+	}
+	return nil
+}
+
 // HandleInput processes keyboard input
 func (c *Container) HandleInput(key string) {
 	// Pass input to content
@@ -350,6 +370,34 @@ func (c *Container) GetSize() (width, height int) {
 }
 
 
+// Measure returns the size needed to fit the content, border and padding
+func (c *Container) Measure(maxWidth, maxHeight int) (width, height int) {
+	chromeWidth := c.padding.Left + c.padding.Right
+	chromeHeight := c.padding.Top + c.padding.Bottom
+	if c.showBorder {
+		chromeWidth += 2
+		chromeHeight += 2
+	}
+
+	if c.content != nil {
+		width, height = MeasureComponent(c.content, maxWidth-chromeWidth, maxHeight-chromeHeight)
+	}
+	width += chromeWidth
+	height += chromeHeight
+
+	// Leave room for the elements embedded in the top and bottom borders
+	if c.showBorder {
+		for _, position := range []BorderPosition{BorderTop, BorderBottom} {
+			elementsWidth := 2
+			for _, elem := range c.getElementsForPosition(position) {
+				elementsWidth += elem.Offset + elem.Element.Width()
+			}
+			width = max(width, elementsWidth)
+		}
+	}
+	return width, height
+}
+
 // Panel is a convenience function to create a container with common settings
 func Panel(title string, content Component) *Container {
 	container := NewContainer()
@@ -80,6 +80,11 @@ func (t *TextElement) Width() int {
 	return StringWidth(t.text) + 2 // +2 for padding spaces
 }
 
+// Measure returns the preferred size of the text element
+func (t *TextElement) Measure(maxWidth, maxHeight int) (width, height int) {
+	return t.Width(), 1
+}
+
 // TabsElement represents tabs that can be embedded in a border
 type TabsElement struct {
 	tabs        []string
@@ -278,3 +283,41 @@ func (b *BadgeElement) Draw(screen *Screen, x, y int, theme *Theme, focused bool
 func (b *BadgeElement) Width() int {
 	return StringWidth(b.text) + 4 // text + "()" + spaces
 }
+
+// ComponentElement embeds a one-row component, such as a Spinner or a
+// ProgressBar, in a border or a status bar
+type ComponentElement struct {
+	component Component
+	width     int
+}
+
+// NewComponentElement creates an element drawing a component on one row
+// A width of 0 uses the component's measured width.
+func NewComponentElement(component Component, width int) *ComponentElement {
+	return &ComponentElement{component: component, width: width}
+}
+
+// Component returns the embedded component
+func (c *ComponentElement) Component() Component {
+	return c.component
+}
+
+// Draw renders the component with a space on either side
+func (c *ComponentElement) Draw(screen *Screen, x, y int, theme *Theme, focused bool) int {
+	width := c.Width()
+	screen.DrawComponent(c.component, x+1, y, width-2, 1, theme)
+	return width
+}
+
+// Width returns the component's width plus padding
+func (c *ComponentElement) Width() int {
+	if c.width > 0 {
+		return c.width + 2
+	}
+	measurer, ok := c.component.(Measurer)
+	if !ok {
+		return 2
+	}
+	w, _ := measurer.Measure(1<<16, 1)
+	return w + 2
+}
@@ -0,0 +1,732 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// controlColors returns the Interactive colors of a control in a state
+func controlColors(theme *Theme, disabled, focused bool) StateColors {
+	interactive := theme.Components.Interactive
+	switch {
+	case disabled:
+		return interactive.Disabled
+	case focused:
+		return interactive.Hover
+	}
+	return interactive.Normal
+}
+
+// controlStyle returns a text style with a control's colors
+func controlStyle(theme *Theme, disabled, focused bool) lipgloss.Style {
+	colors := controlColors(theme, disabled, focused)
+	return lipgloss.NewStyle().Foreground(colors.Text).Background(colors.Background)
+}
+
+// markStyle returns the style of a checked mark, like "x" in "[x]"
+func markStyle(theme *Theme, disabled, on bool) lipgloss.Style {
+	interactive := theme.Components.Interactive
+	colors := interactive.Normal
+	switch {
+	case disabled:
+		colors = interactive.Disabled
+	case on:
+		colors = interactive.Selected
+	}
+	return lipgloss.NewStyle().Foreground(colors.Text).Background(colors.Background)
+}
+
+// Button is a focusable control that runs an action when pressed
+type Button struct {
+	label    string
+	focused  bool
+	disabled bool
+	onPress  func()
+	keymap   *Keymap
+}
+
+// NewButton creates a new button
+func NewButton(label string) *Button {
+	return &Button{
+		label:  label,
+		keymap: DefaultButtonKeymap(),
+	}
+}
+
+// DefaultButtonKeymap returns the default key bindings of a Button
+func DefaultButtonKeymap() *Keymap {
+	return NewKeymap("button",
+		KeyBinding{Action: "press", Keys: []string{"enter", " "}, Help: "press"},
+	)
+}
+
+// Keymap returns the button's key bindings
+func (b *Button) Keymap() *Keymap {
+	return b.keymap
+}
+
+// SetKeymap sets the button's key bindings
+func (b *Button) SetKeymap(keymap *Keymap) {
+	b.keymap = keymap
+}
+
+// Label returns the button's label
+func (b *Button) Label() string {
+	return b.label
+}
+
+// SetLabel sets the button's label
+func (b *Button) SetLabel(label string) {
+	b.label = label
+}
+
+// SetDisabled sets whether the button ignores presses
+func (b *Button) SetDisabled(disabled bool) {
+	b.disabled = disabled
+}
+
+// IsDisabled returns whether the button is disabled
+func (b *Button) IsDisabled() bool {
+	return b.disabled
+}
+
+// OnPress sets the function called when the button is pressed
+func (b *Button) OnPress(handler func()) {
+	b.onPress = handler
+}
+
+// Press runs the button's action, unless it is disabled
+func (b *Button) Press() bool {
+	if b.disabled {
+		return false
+	}
+	if b.onPress != nil {
+		b.onPress()
+	}
+	return true
+}
+
+// HandleKey presses the button, returning whether the key was used
+func (b *Button) HandleKey(key string) bool {
+	if b.keymap.Matches(key, "press") {
+		return b.Press()
+	}
+	return false
+}
+
+// HandleInput processes keyboard input
+func (b *Button) HandleInput(key string) {
+	b.HandleKey(key)
+}
+
+// Focus gives keyboard focus to the button
+func (b *Button) Focus() {
+	b.focused = true
+}
+
+// Blur removes keyboard focus from the button
+func (b *Button) Blur() {
+	b.focused = false
+}
+
+// IsFocused returns whether the button has focus
+func (b *Button) IsFocused() bool {
+	return b.focused
+}
+
+// text returns the button as drawn
+func (b *Button) text() string {
+	return "[ " + b.label + " ]"
+}
+
+// Measure returns the width of the bracketed label
+func (b *Button) Measure(maxWidth, maxHeight int) (width, height int) {
+	return min(StringWidth(b.text()), maxWidth), min(1, maxHeight)
+}
+
+// Draw renders the button as "[ label ]", highlighted when focused
+func (b *Button) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	if availableWidth <= 0 || availableHeight <= 0 {
+		return
+	}
+	colors := theme.Components.Interactive.Normal
+	switch {
+	case b.disabled:
+		colors = theme.Components.Interactive.Disabled
+	case b.focused:
+		colors = theme.Components.Interactive.Selected
+	}
+	style := lipgloss.NewStyle().Foreground(colors.Text).Background(colors.Background).Bold(b.focused && !b.disabled)
+	screen.DrawString(x, y, Truncate(b.text(), availableWidth), style)
+}
+
+// Checkbox is a focusable control for a boolean with a label
+type Checkbox struct {
+	label    string
+	checked  bool
+	focused  bool
+	disabled bool
+	onChange func(checked bool)
+	keymap   *Keymap
+}
+
+// NewCheckbox creates a new unchecked checkbox
+func NewCheckbox(label string) *Checkbox {
+	return &Checkbox{
+		label:  label,
+		keymap: DefaultCheckboxKeymap(),
+	}
+}
+
+// DefaultCheckboxKeymap returns the default key bindings of a Checkbox
+func DefaultCheckboxKeymap() *Keymap {
+	return NewKeymap("checkbox",
+		KeyBinding{Action: "toggle", Keys: []string{" "}, Help: "toggle"},
+	)
+}
+
+// Keymap returns the checkbox's key bindings
+func (c *Checkbox) Keymap() *Keymap {
+	return c.keymap
+}
+
+// SetKeymap sets the checkbox's key bindings
+func (c *Checkbox) SetKeymap(keymap *Keymap) {
+	c.keymap = keymap
+}
+
+// Label returns the checkbox's label
+func (c *Checkbox) Label() string {
+	return c.label
+}
+
+// SetLabel sets the checkbox's label
+func (c *Checkbox) SetLabel(label string) {
+	c.label = label
+}
+
+// Checked returns whether the checkbox is checked
+func (c *Checkbox) Checked() bool {
+	return c.checked
+}
+
+// SetChecked checks or unchecks the checkbox without calling OnChange
+func (c *Checkbox) SetChecked(checked bool) {
+	c.checked = checked
+}
+
+// SetDisabled sets whether the checkbox ignores input
+func (c *Checkbox) SetDisabled(disabled bool) {
+	c.disabled = disabled
+}
+
+// IsDisabled returns whether the checkbox is disabled
+func (c *Checkbox) IsDisabled() bool {
+	return c.disabled
+}
+
+// OnChange sets the function called when the user toggles the checkbox
+func (c *Checkbox) OnChange(handler func(checked bool)) {
+	c.onChange = handler
+}
+
+// Toggle flips the checkbox, unless it is disabled
+func (c *Checkbox) Toggle() bool {
+	if c.disabled {
+		return false
+	}
+	c.checked = !c.checked
+	if c.onChange != nil {
+		c.onChange(c.checked)
+	}
+	return true
+}
+
+// FormValue returns whether the checkbox is checked
+func (c *Checkbox) FormValue() any {
+	return c.checked
+}
+
+// SetFormValue checks the checkbox from a bool
+func (c *Checkbox) SetFormValue(value any) error {
+	checked, ok := value.(bool)
+	if !ok {
+		return fmt.Errorf("tui: checkbox value must be a bool, got %T", value)
+	}
+	c.checked = checked
+	return nil
+}
+
+// HandleKey toggles the checkbox, returning whether the key was used
+func (c *Checkbox) HandleKey(key string) bool {
+	if c.keymap.Matches(key, "toggle") {
+		return c.Toggle()
+	}
+	return false
+}
+
+// HandleInput processes keyboard input
+func (c *Checkbox) HandleInput(key string) {
+	c.HandleKey(key)
+}
+
+// Focus gives keyboard focus to the checkbox
+func (c *Checkbox) Focus() {
+	c.focused = true
+}
+
+// Blur removes keyboard focus from the checkbox
+func (c *Checkbox) Blur() {
+	c.focused = false
+}
+
+// IsFocused returns whether the checkbox has focus
+func (c *Checkbox) IsFocused() bool {
+	return c.focused
+}
+
+// Measure returns the width of the box and label
+func (c *Checkbox) Measure(maxWidth, maxHeight int) (width, height int) {
+	return min(4+StringWidth(c.label), maxWidth), min(1, maxHeight)
+}
+
+// Draw renders the checkbox as "[x] label"
+func (c *Checkbox) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	if availableWidth <= 0 || availableHeight <= 0 {
+		return
+	}
+	drawMarked(screen, x, y, availableWidth, "[", "x", "]", c.label, c.checked, c.disabled, c.focused, theme)
+}
+
+// drawMarked draws a bracketed mark followed by a label, like "[x] label"
+func drawMarked(screen *Screen, x, y, width int, left, mark, right, label string, on, disabled, focused bool, theme *Theme) {
+	style := controlStyle(theme, disabled, focused)
+	if !on {
+		mark = " "
+	}
+	text := left + mark + right + " " + label
+	screen.DrawString(x, y, Truncate(text, width), style)
+	if on && width > StringWidth(left) {
+		screen.DrawString(x+StringWidth(left), y, mark, markStyle(theme, disabled, on).Bold(true))
+	}
+}
+
+// Toggle is a focusable on/off switch with a label
+// The switch is drawn at the right edge, which lines toggles up in settings
+// screens.
+type Toggle struct {
+	label    string
+	on       bool
+	onText   string
+	offText  string
+	focused  bool
+	disabled bool
+	onChange func(on bool)
+	keymap   *Keymap
+}
+
+// NewToggle creates a new switched off toggle
+func NewToggle(label string) *Toggle {
+	return &Toggle{
+		label:   label,
+		onText:  "On",
+		offText: "Off",
+		keymap:  DefaultToggleKeymap(),
+	}
+}
+
+// DefaultToggleKeymap returns the default key bindings of a Toggle
+func DefaultToggleKeymap() *Keymap {
+	return NewKeymap("toggle",
+		KeyBinding{Action: "toggle", Keys: []string{" "}, Help: "toggle"},
+		KeyBinding{Action: "on", Keys: []string{"right", "l"}, Help: "switch on"},
+		KeyBinding{Action: "off", Keys: []string{"left", "h"}, Help: "switch off"},
+	)
+}
+
+// Keymap returns the toggle's key bindings
+func (t *Toggle) Keymap() *Keymap {
+	return t.keymap
+}
+
+// SetKeymap sets the toggle's key bindings
+func (t *Toggle) SetKeymap(keymap *Keymap) {
+	t.keymap = keymap
+}
+
+// Label returns the toggle's label
+func (t *Toggle) Label() string {
+	return t.label
+}
+
+// SetLabel sets the toggle's label
+func (t *Toggle) SetLabel(label string) {
+	t.label = label
+}
+
+// SetStateText sets the text shown next to the switch, "On" and "Off" by
+// default
+func (t *Toggle) SetStateText(on, off string) {
+	t.onText = on
+	t.offText = off
+}
+
+// On returns whether the toggle is switched on
+func (t *Toggle) On() bool {
+	return t.on
+}
+
+// SetOn switches the toggle without calling OnChange
+func (t *Toggle) SetOn(on bool) {
+	t.on = on
+}
+
+// SetDisabled sets whether the toggle ignores input
+func (t *Toggle) SetDisabled(disabled bool) {
+	t.disabled = disabled
+}
+
+// IsDisabled returns whether the toggle is disabled
+func (t *Toggle) IsDisabled() bool {
+	return t.disabled
+}
+
+// OnChange sets the function called when the user switches the toggle
+func (t *Toggle) OnChange(handler func(on bool)) {
+	t.onChange = handler
+}
+
+// Switch sets the toggle, calling OnChange if it changed
+// It returns false when the toggle is disabled.
+func (t *Toggle) Switch(on bool) bool {
+	if t.disabled {
+		return false
+	}
+	if t.on != on {
+		t.on = on
+		if t.onChange != nil {
+			t.onChange(on)
+		}
+	}
+	return true
+}
+
+// Flip switches the toggle to the other state
+func (t *Toggle) Flip() bool {
+	return t.Switch(!t.on)
+}
+
+// FormValue returns whether the toggle is on
+func (t *Toggle) FormValue() any {
+	return t.on
+}
+
+// SetFormValue switches the toggle from a bool
+func (t *Toggle) SetFormValue(value any) error {
+	on, ok := value.(bool)
+	if !ok {
+		return fmt.Errorf("tui: toggle value must be a bool, got %T", value)
+	}
+	t.on = on
+	return nil
+}
+
+// HandleKey switches the toggle, returning whether the key was used
+func (t *Toggle) HandleKey(key string) bool {
+	switch t.keymap.Action(key) {
+	case "toggle":
+		return t.Flip()
+	case "on":
+		return t.Switch(true)
+	case "off":
+		return t.Switch(false)
+	}
+	return false
+}
+
+// HandleInput processes keyboard input
+func (t *Toggle) HandleInput(key string) {
+	t.HandleKey(key)
+}
+
+// Focus gives keyboard focus to the toggle
+func (t *Toggle) Focus() {
+	t.focused = true
+}
+
+// Blur removes keyboard focus from the toggle
+func (t *Toggle) Blur() {
+	t.focused = false
+}
+
+// IsFocused returns whether the toggle has focus
+func (t *Toggle) IsFocused() bool {
+	return t.focused
+}
+
+// switchWidth returns the width of the switch and its state text
+func (t *Toggle) switchWidth() int {
+	return 4 + max(StringWidth(t.onText), StringWidth(t.offText))
+}
+
+// Measure returns the width of the label, a gap and the switch
+func (t *Toggle) Measure(maxWidth, maxHeight int) (width, height int) {
+	width = t.switchWidth()
+	if t.label != "" {
+		width += StringWidth(t.label) + 2
+	}
+	return min(width, maxWidth), min(1, maxHeight)
+}
+
+// Draw renders the label on the left and the switch, like "━━● On", on the
+// right
+func (t *Toggle) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	if availableWidth <= 0 || availableHeight <= 0 {
+		return
+	}
+	style := controlStyle(theme, t.disabled, t.focused)
+	switchWidth := t.switchWidth()
+	switchX := x + max(0, availableWidth-switchWidth)
+	if t.label != "" {
+		screen.DrawString(x, y, Truncate(t.label, max(0, switchX-x-1)), style)
+	}
+
+	track, text := "●━━", t.offText
+	if t.on {
+		track, text = "━━●", t.onText
+	}
+	trackStyle := markStyle(theme, t.disabled, t.on)
+	screen.DrawString(switchX, y, Truncate(track, x+availableWidth-switchX), trackStyle)
+	if textX := switchX + 4; textX < x+availableWidth {
+		screen.DrawString(textX, y, Truncate(text, x+availableWidth-textX), trackStyle.Bold(t.on))
+	}
+}
+
+// RadioGroup is a focusable control for picking one of several options
+// Moving between options selects them.
+type RadioGroup struct {
+	options    []string
+	selected   int
+	horizontal bool
+	focused    bool
+	disabled   bool
+	onChange   func(index int, option string)
+	keymap     *Keymap
+}
+
+// NewRadioGroup creates a radio group with the first option selected
+func NewRadioGroup(options ...string) *RadioGroup {
+	return &RadioGroup{
+		options: options,
+		keymap:  DefaultRadioGroupKeymap(),
+	}
+}
+
+// DefaultRadioGroupKeymap returns the default key bindings of a RadioGroup
+func DefaultRadioGroupKeymap() *Keymap {
+	return NewKeymap("radio-group",
+		KeyBinding{Action: "previous", Keys: []string{"up", "k", "left", "h"}, Help: "previous option"},
+		KeyBinding{Action: "next", Keys: []string{"down", "j", "right", "l"}, Help: "next option"},
+		KeyBinding{Action: "first", Keys: []string{"home"}, Help: "first option"},
+		KeyBinding{Action: "last", Keys: []string{"end"}, Help: "last option"},
+	)
+}
+
+// Keymap returns the radio group's key bindings
+func (r *RadioGroup) Keymap() *Keymap {
+	return r.keymap
+}
+
+// SetKeymap sets the radio group's key bindings
+func (r *RadioGroup) SetKeymap(keymap *Keymap) {
+	r.keymap = keymap
+}
+
+// Options returns the options
+func (r *RadioGroup) Options() []string {
+	return r.options
+}
+
+// SetOptions replaces the options, keeping the selection in range
+func (r *RadioGroup) SetOptions(options ...string) {
+	r.options = options
+	r.selected = max(0, min(r.selected, len(options)-1))
+}
+
+// SetHorizontal sets whether the options are laid out in a row instead of a
+// column
+func (r *RadioGroup) SetHorizontal(horizontal bool) {
+	r.horizontal = horizontal
+}
+
+// Selected returns the index of the selected option
+func (r *RadioGroup) Selected() int {
+	return r.selected
+}
+
+// Value returns the selected option, or "" when there are none
+func (r *RadioGroup) Value() string {
+	if r.selected < len(r.options) {
+		return r.options[r.selected]
+	}
+	return ""
+}
+
+// SetSelected selects an option by index without calling OnChange
+func (r *RadioGroup) SetSelected(index int) {
+	if index >= 0 && index < len(r.options) {
+		r.selected = index
+	}
+}
+
+// SetValue selects an option by text without calling OnChange
+// It returns false when no option matches.
+func (r *RadioGroup) SetValue(option string) bool {
+	for i, o := range r.options {
+		if o == option {
+			r.selected = i
+			return true
+		}
+	}
+	return false
+}
+
+// SetDisabled sets whether the radio group ignores input
+func (r *RadioGroup) SetDisabled(disabled bool) {
+	r.disabled = disabled
+}
+
+// IsDisabled returns whether the radio group is disabled
+func (r *RadioGroup) IsDisabled() bool {
+	return r.disabled
+}
+
+// OnChange sets the function called when the user selects an option
+func (r *RadioGroup) OnChange(handler func(index int, option string)) {
+	r.onChange = handler
+}
+
+// Select selects an option by index, calling OnChange if it changed
+// It returns false when the radio group is disabled or the index is out of
+// range.
+func (r *RadioGroup) Select(index int) bool {
+	if r.disabled || index < 0 || index >= len(r.options) {
+		return false
+	}
+	if index != r.selected {
+		r.selected = index
+		if r.onChange != nil {
+			r.onChange(index, r.options[index])
+		}
+	}
+	return true
+}
+
+// FormValue returns the selected option
+func (r *RadioGroup) FormValue() any {
+	return r.Value()
+}
+
+// SetFormValue selects an option from its text or index
+func (r *RadioGroup) SetFormValue(value any) error {
+	switch v := value.(type) {
+	case string:
+		if !r.SetValue(v) {
+			return fmt.Errorf("tui: %q is not an option", v)
+		}
+	case int:
+		if v < 0 || v >= len(r.options) {
+			return fmt.Errorf("tui: option %d out of range", v)
+		}
+		r.selected = v
+	default:
+		return fmt.Errorf("tui: radio group value must be a string or int, got %T", value)
+	}
+	return nil
+}
+
+// HandleKey moves the selection, returning whether the key was used
+func (r *RadioGroup) HandleKey(key string) bool {
+	if r.disabled {
+		return false
+	}
+	switch r.keymap.Action(key) {
+	case "previous":
+		if r.selected > 0 {
+			r.Select(r.selected - 1)
+		}
+	case "next":
+		r.Select(r.selected + 1)
+	case "first":
+		r.Select(0)
+	case "last":
+		r.Select(len(r.options) - 1)
+	default:
+		return false
+	}
+	return true
+}
+
+// HandleInput processes keyboard input
+func (r *RadioGroup) HandleInput(key string) {
+	r.HandleKey(key)
+}
+
+// Focus gives keyboard focus to the radio group
+func (r *RadioGroup) Focus() {
+	r.focused = true
+}
+
+// Blur removes keyboard focus from the radio group
+func (r *RadioGroup) Blur() {
+	r.focused = false
+}
+
+// IsFocused returns whether the radio group has focus
+func (r *RadioGroup) IsFocused() bool {
+	return r.focused
+}
+
+// Measure returns the size of the options in a column, or a row when
+// horizontal
+func (r *RadioGroup) Measure(maxWidth, maxHeight int) (width, height int) {
+	for i, option := range r.options {
+		w := 4 + StringWidth(option)
+		if r.horizontal {
+			if i > 0 {
+				width += 2
+			}
+			width += w
+		} else {
+			width = max(width, w)
+		}
+	}
+	height = len(r.options)
+	if r.horizontal {
+		height = min(1, len(r.options))
+	}
+	return min(width, maxWidth), min(height, maxHeight)
+}
+
+// Draw renders each option as "(•) option", highlighting the selected one
+// while focused
+func (r *RadioGroup) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	if availableWidth <= 0 || availableHeight <= 0 {
+		return
+	}
+	col, row := x, y
+	for i, option := range r.options {
+		width := x + availableWidth - col
+		if width <= 0 || row >= y+availableHeight {
+			break
+		}
+		selected := i == r.selected
+		drawMarked(screen, col, row, width, "(", "•", ")", option, selected, r.disabled, r.focused && selected, theme)
+		if r.horizontal {
+			col += 4 + StringWidth(option) + 2
+		} else {
+			row++
+		}
+	}
+}
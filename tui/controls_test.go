@@ -0,0 +1,174 @@
+package tui
+
+import "testing"
+
+func TestButtonPress(t *testing.T) {
+	presses := 0
+	button := NewButton("Save")
+	button.OnPress(func() { presses++ })
+
+	if !button.HandleKey("enter") || !button.HandleKey(" ") {
+		t.Error("Expected enter and space to press the button")
+	}
+	if button.HandleKey("x") {
+		t.Error("Expected other keys to be ignored")
+	}
+	button.SetDisabled(true)
+	if button.HandleKey("enter") {
+		t.Error("Expected a disabled button to ignore presses")
+	}
+	if presses != 2 {
+		t.Errorf("Expected 2 presses, got %d", presses)
+	}
+}
+
+func TestCheckboxToggle(t *testing.T) {
+	var changes []bool
+	checkbox := NewCheckbox("Word wrap")
+	checkbox.OnChange(func(checked bool) { changes = append(changes, checked) })
+
+	checkbox.HandleKey(" ")
+	if !checkbox.Checked() {
+		t.Error("Expected space to check the checkbox")
+	}
+	if checkbox.HandleKey("enter") {
+		t.Error("Expected enter to be left for the parent")
+	}
+	checkbox.SetChecked(false) // No callback
+	checkbox.SetDisabled(true)
+	checkbox.HandleKey(" ")
+	if checkbox.Checked() {
+		t.Error("Expected a disabled checkbox to ignore input")
+	}
+	if len(changes) != 1 || !changes[0] {
+		t.Errorf("Expected one change to true, got %v", changes)
+	}
+
+	if err := checkbox.SetFormValue(true); err != nil || checkbox.FormValue() != true {
+		t.Errorf("Expected the form value set, got %v (%v)", checkbox.FormValue(), err)
+	}
+	if err := checkbox.SetFormValue("yes"); err == nil {
+		t.Error("Expected an error for a non-bool value")
+	}
+}
+
+func TestCheckboxDraw(t *testing.T) {
+	checkbox := NewCheckbox("Minimap")
+	checkbox.SetChecked(true)
+
+	sim := NewScreenSimulation(20, 1)
+	checkbox.Draw(sim.Screen, 0, 0, 20, 1, NewTestTheme())
+	AssertTextExists(t, sim, "[x] Minimap")
+
+	checkbox.SetChecked(false)
+	checkbox.Draw(sim.Screen, 0, 0, 20, 1, NewTestTheme())
+	AssertTextExists(t, sim, "[ ] Minimap")
+}
+
+func TestToggleSwitch(t *testing.T) {
+	var changes []bool
+	toggle := NewToggle("Auto save")
+	toggle.OnChange(func(on bool) { changes = append(changes, on) })
+
+	toggle.HandleKey("right")
+	toggle.HandleKey("l") // Already on, no change
+	toggle.HandleKey(" ")
+	toggle.HandleKey("left")
+	if toggle.On() {
+		t.Error("Expected the toggle to be off")
+	}
+	if len(changes) != 2 || !changes[0] || changes[1] {
+		t.Errorf("Expected on then off, got %v", changes)
+	}
+}
+
+func TestToggleDraw(t *testing.T) {
+	toggle := NewToggle("Line numbers")
+	toggle.SetOn(true)
+
+	sim := NewScreenSimulation(30, 1)
+	toggle.Draw(sim.Screen, 0, 0, 30, 1, NewTestTheme())
+
+	AssertTextExists(t, sim, "Line numbers")
+	// The switch is right-aligned: "━━● On " in the last 7 columns
+	AssertCellRune(t, sim, 25, 0, '●')
+	AssertCellRune(t, sim, 27, 0, 'O')
+
+	if w, h := toggle.Measure(100, 10); w != 12+2+7 || h != 1 {
+		t.Errorf("Expected 21x1, got %dx%d", w, h)
+	}
+}
+
+func TestRadioGroupSelection(t *testing.T) {
+	var changed []string
+	radio := NewRadioGroup("Spaces", "Tabs", "Mixed")
+	radio.OnChange(func(index int, option string) { changed = append(changed, option) })
+
+	radio.HandleKey("down")
+	radio.HandleKey("end")
+	radio.HandleKey("down") // Stays on the last option
+	radio.HandleKey("up")
+	if radio.Value() != "Tabs" || radio.Selected() != 1 {
+		t.Errorf("Expected Tabs selected, got %q", radio.Value())
+	}
+	if len(changed) != 3 {
+		t.Errorf("Expected 3 changes, got %v", changed)
+	}
+
+	if err := radio.SetFormValue("Mixed"); err != nil || radio.FormValue() != "Mixed" {
+		t.Errorf("Expected Mixed, got %v (%v)", radio.FormValue(), err)
+	}
+	if err := radio.SetFormValue("Other"); err == nil {
+		t.Error("Expected an error for an unknown option")
+	}
+
+	radio.SetDisabled(true)
+	if radio.HandleKey("up") {
+		t.Error("Expected a disabled radio group to ignore input")
+	}
+}
+
+func TestRadioGroupDraw(t *testing.T) {
+	radio := NewRadioGroup("Light", "Dark")
+	radio.SetSelected(1)
+
+	sim := NewScreenSimulation(20, 2)
+	radio.Draw(sim.Screen, 0, 0, 20, 2, NewTestTheme())
+	AssertTextExists(t, sim, "( ) Light")
+	AssertTextExists(t, sim, "(•) Dark")
+
+	radio.SetHorizontal(true)
+	if w, h := radio.Measure(100, 10); w != 9+2+8 || h != 1 {
+		t.Errorf("Expected 19x1, got %dx%d", w, h)
+	}
+}
+
+func TestFocusTargetsSkipDisabled(t *testing.T) {
+	enabled := NewCheckbox("a")
+	disabled := NewButton("b")
+	disabled.SetDisabled(true)
+
+	layout := VBox()
+	layout.AddFlex(enabled, 1)
+	layout.AddFlex(disabled, 1)
+
+	targets := FocusTargets(layout)
+	if len(targets) != 1 || targets[0] != Component(enabled) {
+		t.Errorf("Expected only the enabled checkbox, got %v", targets)
+	}
+}
+
+func TestFormWithControls(t *testing.T) {
+	form := NewForm()
+	form.AddField("wrap", "Wrap", NewCheckbox("Wrap long lines"))
+	form.AddField("indent", "Indent", NewRadioGroup("Spaces", "Tabs"))
+	form.Focus()
+
+	form.HandleKey(" ")
+	form.HandleKey("enter") // Advances past the checkbox
+	form.HandleKey("down")
+	values := form.Values()
+	if values["wrap"] != true || values["indent"] != "Tabs" {
+		t.Errorf("Expected the control values, got %v", values)
+	}
+}
@@ -0,0 +1,579 @@
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// DialogKind identifies the kind of prebuilt dialog
+type DialogKind int
+
+const (
+	DialogConfirm DialogKind = iota
+	DialogPrompt
+	DialogAlert
+	DialogChoose
+)
+
+// DialogResult is how a dialog was closed
+type DialogResult struct {
+	Button    string // Label of the button pressed; empty for Choose or when cancelled
+	Index     int    // Index of the button pressed, or of the option chosen; -1 when cancelled
+	Value     string // Text entered in a Prompt, or the option chosen in Choose
+	Cancelled bool   // Closed with esc, or with a Prompt's Cancel button
+}
+
+// DialogResultMsg is the bubbletea message sent when a dialog closes
+// See Dialog.Update.
+type DialogResultMsg struct {
+	ID     string
+	Result DialogResult
+}
+
+// Dialog is a prebuilt modal dialog: a Modal holding a Container with a
+// message, an optional input or option list, and a row of buttons
+// Create one with Confirm, Prompt, Alert or Choose.
+type Dialog struct {
+	id          string
+	kind        DialogKind
+	modal       *Modal
+	container   *Container
+	message     string
+	buttons     []string
+	selected    int    // Highlighted button
+	input       *Input // Prompt only
+	onInput     bool   // Whether keys go to the input rather than the buttons
+	options     []string
+	choice      int // Highlighted option
+	offset      int // First visible option
+	onResult    func(DialogResult)
+	result      *DialogResult
+	focused     bool
+	keymap      *Keymap
+	inputKeymap *Keymap
+}
+
+// newDialog creates a dialog with its modal and container
+func newDialog(kind DialogKind, title, message string, buttons []string) *Dialog {
+	d := &Dialog{
+		kind:        kind,
+		message:     message,
+		buttons:     buttons,
+		choice:      0,
+		keymap:      DefaultDialogKeymap(),
+		inputKeymap: DefaultDialogInputKeymap(),
+	}
+
+	d.container = NewContainer()
+	d.container.SetTitle(title)
+	d.container.SetPadding(NewMarginTB(1, 2))
+	d.container.SetContent(&dialogBody{dialog: d})
+
+	d.modal = NewModal()
+	d.modal.SetContent(d.container)
+	d.modal.SetSizeConstraints(
+		NewConstraintSet(NewAuto()).WithMin(30),
+		NewConstraintSet(NewAuto()),
+	)
+	d.modal.SetDimBackground(true)
+	return d
+}
+
+// DefaultDialogKeymap returns the default key bindings for a dialog's
+// buttons and options
+func DefaultDialogKeymap() *Keymap {
+	return NewKeymap("dialog",
+		KeyBinding{Action: "previous", Keys: []string{"left", "h", "up", "k", "shift+tab"}, Help: "previous"},
+		KeyBinding{Action: "next", Keys: []string{"right", "l", "down", "j", "tab"}, Help: "next"},
+		KeyBinding{Action: "first", Keys: []string{"home", "g"}, Help: "first"},
+		KeyBinding{Action: "last", Keys: []string{"end", "G"}, Help: "last"},
+		KeyBinding{Action: "press", Keys: []string{"enter", " "}, Help: "select"},
+		KeyBinding{Action: "cancel", Keys: []string{"esc"}, Help: "cancel"},
+	)
+}
+
+// DefaultDialogInputKeymap returns the default key bindings used while a
+// Prompt's input has focus
+// Keys not bound here are typed into the input.
+func DefaultDialogInputKeymap() *Keymap {
+	return NewKeymap("dialog-input",
+		KeyBinding{Action: "submit", Keys: []string{"enter"}, Help: "ok"},
+		KeyBinding{Action: "next", Keys: []string{"tab"}, Help: "buttons"},
+		KeyBinding{Action: "previous", Keys: []string{"shift+tab"}},
+		KeyBinding{Action: "cancel", Keys: []string{"esc"}, Help: "cancel"},
+	)
+}
+
+// Keymap returns the key bindings for the dialog's buttons and options
+func (d *Dialog) Keymap() *Keymap {
+	return d.keymap
+}
+
+// SetKeymap sets the key bindings for the dialog's buttons and options
+func (d *Dialog) SetKeymap(keymap *Keymap) {
+	d.keymap = keymap
+}
+
+// InputKeymap returns the key bindings used while a Prompt's input has focus
+func (d *Dialog) InputKeymap() *Keymap {
+	return d.inputKeymap
+}
+
+// SetInputKeymap sets the key bindings used while a Prompt's input has focus
+func (d *Dialog) SetInputKeymap(keymap *Keymap) {
+	d.inputKeymap = keymap
+}
+
+// Confirm creates a dialog asking the user to pick one of several buttons
+// With no buttons it offers "OK" and "Cancel".
+func Confirm(title, message string, buttons ...string) *Dialog {
+	if len(buttons) == 0 {
+		buttons = []string{"OK", "Cancel"}
+	}
+	return newDialog(DialogConfirm, title, message, buttons)
+}
+
+// Prompt creates a dialog asking the user for a line of text
+// Pressing Cancel or esc cancels; enter in the input presses OK.
+func Prompt(title, defaultValue string) *Dialog {
+	d := newDialog(DialogPrompt, title, "", []string{"OK", "Cancel"})
+	d.input = NewInput()
+	d.input.SetValue(defaultValue)
+	d.setOnInput(true)
+	return d
+}
+
+// Alert creates a dialog showing a message with a single OK button
+func Alert(title, message string) *Dialog {
+	return newDialog(DialogAlert, title, message, []string{"OK"})
+}
+
+// Choose creates a dialog asking the user to pick one of a list of options
+func Choose(title string, options []string) *Dialog {
+	d := newDialog(DialogChoose, title, "", nil)
+	d.options = options
+	return d
+}
+
+// Kind returns the kind of dialog
+func (d *Dialog) Kind() DialogKind {
+	return d.kind
+}
+
+// SetID sets the identifier sent with DialogResultMsg
+func (d *Dialog) SetID(id string) {
+	d.id = id
+}
+
+// ID returns the dialog's identifier
+func (d *Dialog) ID() string {
+	return d.id
+}
+
+// SetMessage sets the text shown above the input, options or buttons
+func (d *Dialog) SetMessage(message string) {
+	d.message = message
+}
+
+// OnResult sets a callback run when the dialog closes
+func (d *Dialog) OnResult(callback func(DialogResult)) {
+	d.onResult = callback
+}
+
+// Result returns how the dialog was last closed
+// Returns false while it hasn't closed since it was shown.
+func (d *Dialog) Result() (DialogResult, bool) {
+	if d.result == nil {
+		return DialogResult{}, false
+	}
+	return *d.result, true
+}
+
+// Modal returns the dialog's modal, for changing its size or dimming
+func (d *Dialog) Modal() *Modal {
+	return d.modal
+}
+
+// Input returns the text input of a Prompt, or nil
+func (d *Dialog) Input() *Input {
+	return d.input
+}
+
+// SelectedButton returns the index of the highlighted button
+func (d *Dialog) SelectedButton() int {
+	return d.selected
+}
+
+// SelectedOption returns the index of the highlighted option in Choose
+func (d *Dialog) SelectedOption() int {
+	return d.choice
+}
+
+// Show opens the dialog, highlighting the first button or option
+func (d *Dialog) Show() {
+	d.result = nil
+	d.selected = 0
+	d.choice = 0
+	d.offset = 0
+	d.setOnInput(d.input != nil)
+	d.modal.Show()
+}
+
+// Hide closes the dialog
+// Closing an open dialog without a result counts as cancelling it.
+func (d *Dialog) Hide() {
+	if d.modal.IsVisible() && d.result == nil {
+		d.finish(DialogResult{Index: -1, Cancelled: true})
+		return
+	}
+	d.modal.Hide()
+}
+
+// IsVisible returns whether the dialog is open
+func (d *Dialog) IsVisible() bool {
+	return d.modal.IsVisible()
+}
+
+// finish records the result, closes the dialog and runs the callback
+func (d *Dialog) finish(result DialogResult) {
+	if d.input != nil {
+		result.Value = d.input.Value()
+	}
+	d.result = &result
+	d.modal.Hide()
+	if d.onResult != nil {
+		d.onResult(result)
+	}
+}
+
+// press closes the dialog with a button
+func (d *Dialog) press(index int) {
+	result := DialogResult{Button: d.buttons[index], Index: index}
+	// A Prompt's second button is Cancel
+	if d.kind == DialogPrompt && index == 1 {
+		result.Cancelled = true
+	}
+	d.finish(result)
+}
+
+// moveButton highlights the next or previous button, wrapping around
+func (d *Dialog) moveButton(delta int) {
+	if len(d.buttons) > 0 {
+		d.selected = (d.selected + delta + len(d.buttons)) % len(d.buttons)
+	}
+}
+
+// moveChoice highlights another option, clamped to the list
+func (d *Dialog) moveChoice(index int) {
+	d.choice = max(0, min(index, len(d.options)-1))
+}
+
+// HandleKey processes keyboard input while the dialog is open
+// Returns true if the key was handled
+func (d *Dialog) HandleKey(key string) bool {
+	if !d.IsVisible() {
+		return false
+	}
+	if d.onInput {
+		return d.handleInputKey(key)
+	}
+
+	action := d.keymap.Action(key)
+	if action == "cancel" {
+		d.Hide()
+		return true
+	}
+	if d.kind == DialogChoose {
+		return d.handleChooseKey(action)
+	}
+
+	switch action {
+	case "previous":
+		d.moveButton(-1)
+		d.wrapToInput(len(d.buttons) - 1)
+	case "next":
+		d.moveButton(1)
+		d.wrapToInput(0)
+	case "first":
+		d.selected = 0
+	case "last":
+		d.selected = len(d.buttons) - 1
+	case "press":
+		d.press(d.selected)
+	default:
+		return false
+	}
+	return true
+}
+
+// wrapToInput moves focus from a Prompt's buttons back to its input when
+// moving between buttons wraps around to wrapped
+func (d *Dialog) wrapToInput(wrapped int) {
+	if d.input != nil && d.IsVisible() && d.selected == wrapped && len(d.buttons) > 1 {
+		d.setOnInput(true)
+	}
+}
+
+// setOnInput moves keys to a Prompt's input or away to the buttons
+// The input is focused while it receives keys, so it shows its cursor.
+func (d *Dialog) setOnInput(on bool) {
+	d.onInput = on
+	if d.input == nil {
+		return
+	}
+	if on {
+		d.input.Focus()
+	} else {
+		d.input.Blur()
+	}
+}
+
+// handleInputKey handles keys while a Prompt's input has focus
+func (d *Dialog) handleInputKey(key string) bool {
+	switch d.inputKeymap.Action(key) {
+	case "cancel":
+		d.Hide()
+	case "submit":
+		d.press(0)
+	case "next":
+		d.setOnInput(false)
+		d.selected = 0
+	case "previous":
+		d.setOnInput(false)
+		d.selected = len(d.buttons) - 1
+	default:
+		d.input.HandleInput(key)
+	}
+	return true
+}
+
+// handleChooseKey handles actions in a Choose dialog
+func (d *Dialog) handleChooseKey(action string) bool {
+	switch action {
+	case "previous":
+		d.moveChoice(d.choice - 1)
+	case "next":
+		d.moveChoice(d.choice + 1)
+	case "first":
+		d.moveChoice(0)
+	case "last":
+		d.moveChoice(len(d.options) - 1)
+	case "press":
+		if len(d.options) > 0 {
+			d.finish(DialogResult{Index: d.choice, Value: d.options[d.choice]})
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// Update handles a bubbletea message and returns a command that sends a
+// DialogResultMsg when the dialog closes
+func (d *Dialog) Update(msg tea.Msg) tea.Cmd {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || !d.IsVisible() {
+		return nil
+	}
+
+	d.HandleKey(keyMsg.String())
+	if d.result == nil {
+		return nil
+	}
+	resultMsg := DialogResultMsg{ID: d.id, Result: *d.result}
+	return func() tea.Msg {
+		return resultMsg
+	}
+}
+
+// Draw renders the dialog centered in the available space
+func (d *Dialog) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	d.modal.Draw(screen, x, y, availableWidth, availableHeight, theme)
+}
+
+// HandleInput processes keyboard input
+func (d *Dialog) HandleInput(key string) {
+	d.HandleKey(key)
+}
+
+// Focus gives keyboard focus to this component
+func (d *Dialog) Focus() {
+	d.focused = true
+	d.container.Focus()
+}
+
+// Blur removes keyboard focus from this component
+func (d *Dialog) Blur() {
+	d.focused = false
+	d.container.Blur()
+}
+
+// IsFocused returns whether this component currently has focus
+func (d *Dialog) IsFocused() bool {
+	return d.focused
+}
+
+// Measure returns the dialog's size when drawn in the given space
+func (d *Dialog) Measure(maxWidth, maxHeight int) (width, height int) {
+	return d.modal.Measure(maxWidth, maxHeight)
+}
+
+// dialogBody draws a dialog's message, input or options, and buttons
+type dialogBody struct {
+	dialog *Dialog
+}
+
+// dialogMaxTextWidth is the widest a dialog's message grows before wrapping
+const dialogMaxTextWidth = 56
+
+// buttonsWidth returns the width of the button row
+func (b *dialogBody) buttonsWidth() int {
+	width := 0
+	for i, label := range b.dialog.buttons {
+		if i > 0 {
+			width += 2
+		}
+		width += StringWidth(label) + 4
+	}
+	return width
+}
+
+// messageLines wraps the message to a width
+func (b *dialogBody) messageLines(width int) []string {
+	if b.dialog.message == "" {
+		return nil
+	}
+	var lines []string
+	for _, paragraph := range strings.Split(b.dialog.message, "\n") {
+		if paragraph == "" {
+			lines = append(lines, "")
+			continue
+		}
+		lines = append(lines, Wrap(paragraph, width)...)
+	}
+	return lines
+}
+
+// Measure returns the size needed for the message, input, options and buttons
+func (b *dialogBody) Measure(maxWidth, maxHeight int) (width, height int) {
+	d := b.dialog
+	textWidth := min(maxWidth, dialogMaxTextWidth)
+
+	for _, line := range strings.Split(d.message, "\n") {
+		width = max(width, min(StringWidth(line), textWidth))
+	}
+	height = len(b.messageLines(max(1, width)))
+
+	if d.input != nil {
+		width = max(width, min(40, textWidth))
+		if height > 0 {
+			height++ // Gap above the input
+		}
+		height++
+	}
+	for _, option := range d.options {
+		width = max(width, min(StringWidth(option)+2, textWidth))
+	}
+	height += len(d.options)
+
+	if len(d.buttons) > 0 {
+		width = max(width, b.buttonsWidth())
+		if height > 0 {
+			height++ // Gap above the buttons
+		}
+		height++
+	}
+	return min(width, maxWidth), min(height, maxHeight)
+}
+
+// Draw renders the body in the container's content area
+func (b *dialogBody) Draw(screen *Screen, x, y, width, height int, theme *Theme) {
+	d := b.dialog
+	textStyle := lipgloss.NewStyle().
+		Foreground(theme.Palette.Text).
+		Background(theme.Palette.Surface)
+
+	row := y
+	bottom := y + height
+	buttonsRow := bottom - 1
+	if len(d.buttons) == 0 {
+		buttonsRow = bottom
+	}
+
+	for _, line := range b.messageLines(width) {
+		if row >= buttonsRow {
+			break
+		}
+		screen.DrawString(x, row, line, textStyle)
+		row++
+	}
+
+	if d.input != nil && row < buttonsRow {
+		if row > y && row+1 < buttonsRow {
+			row++ // Gap above the input
+		}
+		screen.DrawComponent(d.input, x, row, width, 1, theme)
+		row++
+	}
+
+	if len(d.options) > 0 {
+		b.drawOptions(screen, x, row, width, buttonsRow-row, theme)
+	}
+
+	if len(d.buttons) > 0 {
+		b.drawButtons(screen, x, buttonsRow, width, theme)
+	}
+}
+
+// drawOptions draws the Choose list, scrolled to keep the choice visible
+func (b *dialogBody) drawOptions(screen *Screen, x, y, width, height int, theme *Theme) {
+	d := b.dialog
+	if height <= 0 {
+		return
+	}
+	if d.choice < d.offset {
+		d.offset = d.choice
+	} else if d.choice >= d.offset+height {
+		d.offset = d.choice - height + 1
+	}
+
+	colors := theme.Components.Interactive
+	for i := 0; i < height && d.offset+i < len(d.options); i++ {
+		index := d.offset + i
+		state, marker := colors.Normal, "  "
+		if index == d.choice {
+			state, marker = colors.Selected, "> "
+		}
+		style := lipgloss.NewStyle().Foreground(state.Text).Background(state.Background)
+		line := FillRight(Truncate(marker+d.options[index], width), width)
+		screen.DrawString(x, y+i, line, style)
+	}
+}
+
+// drawButtons draws the button row centered, highlighting the selected one
+func (b *dialogBody) drawButtons(screen *Screen, x, y, width int, theme *Theme) {
+	d := b.dialog
+	colors := theme.Components.Interactive
+	buttonX := x + max(0, (width-b.buttonsWidth())/2)
+
+	for i, label := range d.buttons {
+		state := colors.Normal
+		if i == d.selected && !d.onInput {
+			state = colors.Selected
+		}
+		style := lipgloss.NewStyle().Foreground(state.Text).Background(state.Background)
+		if i == d.selected && !d.onInput {
+			style = style.Bold(true)
+		}
+		text := "[ " + label + " ]"
+		screen.DrawString(buttonX, y, text, style)
+		buttonX += StringWidth(text) + 2
+	}
+}
+
+// HandleInput processes keyboard input
+func (b *dialogBody) HandleInput(key string) {
+	b.dialog.HandleKey(key)
+}
@@ -0,0 +1,173 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestConfirmNavigatesButtons(t *testing.T) {
+	var got DialogResult
+	dialog := Confirm("Delete", "Delete this file?", "Delete", "Keep", "Cancel")
+	dialog.OnResult(func(result DialogResult) { got = result })
+	dialog.Show()
+
+	dialog.HandleKey("right")
+	dialog.HandleKey("right")
+	dialog.HandleKey("right") // Wraps to the first button
+	dialog.HandleKey("left")  // And back to the last
+	if dialog.SelectedButton() != 2 {
+		t.Fatalf("Expected the last button selected, got %d", dialog.SelectedButton())
+	}
+	dialog.HandleKey("shift+tab")
+	dialog.HandleKey("enter")
+
+	if dialog.IsVisible() {
+		t.Error("Expected the dialog to close")
+	}
+	if got.Button != "Keep" || got.Index != 1 || got.Cancelled {
+		t.Errorf("Expected Keep to be pressed, got %+v", got)
+	}
+	if result, ok := dialog.Result(); !ok || result != got {
+		t.Errorf("Expected Result to match the callback, got %+v", result)
+	}
+}
+
+func TestConfirmEscCancels(t *testing.T) {
+	var got DialogResult
+	dialog := Confirm("Quit", "Quit without saving?")
+	dialog.OnResult(func(result DialogResult) { got = result })
+	dialog.Show()
+
+	dialog.HandleKey("esc")
+	if !got.Cancelled || got.Index != -1 {
+		t.Errorf("Expected a cancelled result, got %+v", got)
+	}
+
+	// Closing through an overlay manager cancels too
+	got = DialogResult{}
+	overlays := NewOverlayManager()
+	overlays.Open(dialog, nil)
+	if _, ok := dialog.Result(); ok {
+		t.Error("Expected no result after reopening")
+	}
+	overlays.HandleKey("esc")
+	if !got.Cancelled || dialog.IsVisible() {
+		t.Errorf("Expected the overlay manager's esc to cancel, got %+v", got)
+	}
+}
+
+func TestPromptReturnsText(t *testing.T) {
+	dialog := Prompt("Rename", "old.go")
+	dialog.Show()
+
+	for _, key := range []string{"backspace", "backspace", "t", "x", "t"} {
+		dialog.HandleKey(key)
+	}
+	dialog.HandleKey("enter")
+
+	result, ok := dialog.Result()
+	if !ok || result.Value != "old.txt" || result.Button != "OK" || result.Cancelled {
+		t.Errorf("Expected OK with old.txt, got %+v", result)
+	}
+
+	// Tab moves from the input to the buttons, taking focus from the
+	// input without a redraw; Cancel cancels
+	dialog.Show()
+	if !dialog.Input().IsFocused() {
+		t.Error("Expected the input focused when the prompt opens")
+	}
+	dialog.HandleKey("tab")
+	if dialog.Input().IsFocused() {
+		t.Error("Expected the input blurred once keys go to the buttons")
+	}
+	dialog.HandleKey("tab")
+	if dialog.SelectedButton() != 1 {
+		t.Fatalf("Expected Cancel selected, got %d", dialog.SelectedButton())
+	}
+	dialog.HandleKey("tab") // Wraps back to the input
+	if !dialog.Input().IsFocused() {
+		t.Error("Expected the input focused again")
+	}
+	dialog.HandleKey("!")
+	if dialog.Input().Value() != "old.txt!" {
+		t.Errorf("Expected typing to reach the input again, got %q", dialog.Input().Value())
+	}
+	dialog.HandleKey("shift+tab")
+	dialog.HandleKey("enter")
+	if result, _ := dialog.Result(); !result.Cancelled || result.Button != "Cancel" {
+		t.Errorf("Expected Cancel, got %+v", result)
+	}
+}
+
+func TestChooseSelectsOption(t *testing.T) {
+	dialog := Choose("Theme", []string{"tokyonight", "catppuccin", "rosepine"})
+	dialog.Show()
+
+	dialog.HandleKey("down")
+	dialog.HandleKey("down")
+	dialog.HandleKey("down") // Stays on the last option
+	dialog.HandleKey("k")
+	dialog.HandleKey("enter")
+
+	result, ok := dialog.Result()
+	if !ok || result.Index != 1 || result.Value != "catppuccin" {
+		t.Errorf("Expected catppuccin, got %+v", result)
+	}
+}
+
+func TestDialogUpdateSendsMessage(t *testing.T) {
+	dialog := Alert("Saved", "All changes were saved.")
+	dialog.SetID("saved")
+	dialog.Show()
+
+	if cmd := dialog.Update(tea.KeyMsg{Type: tea.KeyRight}); cmd != nil {
+		t.Error("Expected no command while the dialog stays open")
+	}
+	cmd := dialog.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("Expected a command when the dialog closes")
+	}
+	msg, ok := cmd().(DialogResultMsg)
+	if !ok || msg.ID != "saved" || msg.Result.Button != "OK" {
+		t.Errorf("Expected a DialogResultMsg for OK, got %+v", msg)
+	}
+	if cmd := dialog.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil {
+		t.Error("Expected no command once closed")
+	}
+}
+
+func TestDialogDraw(t *testing.T) {
+	screen := NewScreenSimulation(60, 20)
+	theme := NewTestTheme()
+
+	dialog := Confirm("Delete", "Delete this file?", "Yes", "No")
+	dialog.Show()
+	dialog.Draw(screen.Screen, 0, 0, 60, 20, theme)
+
+	AssertTextExists(t, screen, "Delete")
+	AssertTextExists(t, screen, "Delete this file?")
+	AssertTextExists(t, screen, "[ Yes ]  [ No ]")
+
+	// Sized to fit its content, within the minimum width
+	rect := dialog.Modal().GetRect()
+	if rect.Width != 30 || rect.Height != 7 {
+		t.Errorf("Expected a 30x7 dialog, got %dx%d", rect.Width, rect.Height)
+	}
+
+	choose := Choose("Pick", []string{"one", "two"})
+	choose.Show()
+	screen.Clear()
+	choose.Draw(screen.Screen, 0, 0, 60, 20, theme)
+	AssertTextExists(t, screen, "> one")
+	AssertTextExists(t, screen, "  two")
+
+	prompt := Prompt("Name", "tint")
+	prompt.SetMessage("Project name:")
+	prompt.Show()
+	screen.Clear()
+	prompt.Draw(screen.Screen, 0, 0, 60, 20, theme)
+	AssertTextExists(t, screen, "Project name:")
+	AssertTextExists(t, screen, "tint")
+	AssertTextExists(t, screen, "[ OK ]  [ Cancel ]")
+}
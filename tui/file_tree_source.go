@@ -0,0 +1,87 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// FileTreeSource is a TreeDataSource for a directory on disk
+//
+// Node IDs are file paths and Values are the os.DirEntry of each file.
+// Directories are listed before files, each sorted by name ignoring case,
+// and a directory's contents are only read when it's expanded.
+type FileTreeSource struct {
+	root       string
+	showHidden bool
+	dirIcon    string
+	fileIcon   string
+}
+
+// NewFileTreeSource creates a data source listing the contents of a
+// directory
+func NewFileTreeSource(root string) *FileTreeSource {
+	return &FileTreeSource{root: root}
+}
+
+// Root returns the directory being listed
+func (s *FileTreeSource) Root() string {
+	return s.root
+}
+
+// SetShowHidden sets whether files starting with "." are listed
+func (s *FileTreeSource) SetShowHidden(show bool) {
+	s.showHidden = show
+}
+
+// SetIcons sets the icons of directories and files, such as "📁" and "📄"
+func (s *FileTreeSource) SetIcons(dir, file string) {
+	s.dirIcon = dir
+	s.fileIcon = file
+}
+
+// Roots returns the entries of the root directory
+func (s *FileTreeSource) Roots() ([]TreeNode, error) {
+	return s.list(s.root)
+}
+
+// Children returns the entries of a directory
+func (s *FileTreeSource) Children(node TreeNode) ([]TreeNode, error) {
+	return s.list(node.ID)
+}
+
+// list reads a directory into nodes
+func (s *FileTreeSource) list(dir string) ([]TreeNode, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []TreeNode
+	for _, entry := range entries {
+		if !s.showHidden && strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		node := TreeNode{
+			ID:    filepath.Join(dir, entry.Name()),
+			Title: entry.Name(),
+			Leaf:  !entry.IsDir(),
+			Value: entry,
+		}
+		if entry.IsDir() {
+			node.Icon = s.dirIcon
+		} else {
+			node.Icon = s.fileIcon
+		}
+		nodes = append(nodes, node)
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].Leaf != nodes[j].Leaf {
+			return !nodes[i].Leaf
+		}
+		return strings.ToLower(nodes[i].Title) < strings.ToLower(nodes[j].Title)
+	})
+	return nodes, nil
+}
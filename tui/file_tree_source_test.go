@@ -0,0 +1,76 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeTestFiles(t *testing.T, root string, paths ...string) {
+	t.Helper()
+	for _, path := range paths {
+		full := filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(full, nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestFileTreeSourceListsDirectoriesFirst(t *testing.T) {
+	root := t.TempDir()
+	writeTestFiles(t, root, "b.go", "A.md", "zeta/z.go", "alpha/a.go", ".git/config")
+
+	source := NewFileTreeSource(root)
+	nodes, err := source.Roots()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var titles []string
+	for _, node := range nodes {
+		titles = append(titles, node.Title)
+	}
+	if want := []string{"alpha", "zeta", "A.md", "b.go"}; !reflect.DeepEqual(titles, want) {
+		t.Errorf("Expected %v, got %v", want, titles)
+	}
+	if nodes[0].ID != filepath.Join(root, "alpha") || nodes[0].Leaf || !nodes[2].Leaf {
+		t.Errorf("Expected paths as IDs and files as leaves, got %+v", nodes[:3])
+	}
+
+	source.SetShowHidden(true)
+	nodes, _ = source.Roots()
+	if nodes[0].Title != ".git" {
+		t.Errorf("Expected hidden directories when enabled, got %q", nodes[0].Title)
+	}
+}
+
+func TestFileTreeSourceInTree(t *testing.T) {
+	root := t.TempDir()
+	writeTestFiles(t, root, "cmd/app/main.go", "go.mod")
+
+	tree := NewTree(NewFileTreeSource(root))
+	tree.HandleKey("right")
+	tree.HandleKey("right")
+	tree.HandleKey("right")
+	tree.HandleKey("right")
+	if node, _ := tree.Cursor(); node.ID != filepath.Join(root, "cmd", "app", "main.go") {
+		t.Errorf("Expected to reach main.go, got %q", node.ID)
+	}
+
+	// New files show up on reload, with the tree state kept
+	writeTestFiles(t, root, "cmd/app/flags.go")
+	if err := tree.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if len(tree.Visible()) != 5 {
+		t.Errorf("Expected the new file after reload, got %d nodes", len(tree.Visible()))
+	}
+
+	// A missing root directory is an error
+	if err := tree.SetSource(NewFileTreeSource(filepath.Join(root, "missing"))); err == nil {
+		t.Error("Expected an error for a missing directory")
+	}
+}
@@ -0,0 +1,270 @@
+package tui
+
+// Justify represents how items are distributed along a line
+type Justify int
+
+const (
+	JustifyStart Justify = iota
+	JustifyCenter
+	JustifyEnd
+	JustifySpaceBetween
+)
+
+// Flow arranges components left to right, wrapping onto a new line when the
+// width runs out. Each item is sized from its measured size (see Measurer).
+type Flow struct {
+	items       []Component
+	spacing     int // Horizontal gap between items
+	lineSpacing int // Vertical gap between lines
+	padding     Margin
+	justify     Justify
+	alignItems  Alignment
+	width       int
+	height      int
+}
+
+// flowLine is a single wrapped line of items
+type flowLine struct {
+	items   []int // Indices into Flow.items
+	widths  []int
+	heights []int
+	width   int // Total width including spacing
+	height  int // Tallest item
+}
+
+// NewFlow creates a new flow layout
+func NewFlow() *Flow {
+	return &Flow{
+		items:       []Component{},
+		spacing:     1,
+		lineSpacing: 0,
+		padding:     NewMargin(0),
+		justify:     JustifyStart,
+		alignItems:  AlignStart,
+	}
+}
+
+// Add adds a component to the flow
+func (f *Flow) Add(component Component) {
+	f.items = append(f.items, component)
+}
+
+// SetSpacing sets the horizontal gap between items on a line
+func (f *Flow) SetSpacing(spacing int) {
+	f.spacing = spacing
+}
+
+// SetLineSpacing sets the vertical gap between lines
+func (f *Flow) SetLineSpacing(spacing int) {
+	f.lineSpacing = spacing
+}
+
+// SetPadding sets the padding around the layout
+func (f *Flow) SetPadding(padding Margin) {
+	f.padding = padding
+}
+
+// SetJustify sets how items are distributed along each line
+func (f *Flow) SetJustify(justify Justify) {
+	f.justify = justify
+}
+
+// SetAlignItems sets how items are aligned within the height of their line
+// AlignStretch makes every item as tall as its line
+func (f *Flow) SetAlignItems(alignment Alignment) {
+	f.alignItems = alignment
+}
+
+// SetSize sets the width and height of the layout
+func (f *Flow) SetSize(width, height int) {
+	f.width = width
+	f.height = height
+}
+
+// GetSize returns the current width and height
+func (f *Flow) GetSize() (width, height int) {
+	return f.width, f.height
+}
+
+// layoutLines measures the items and breaks them into lines
+func (f *Flow) layoutLines(maxWidth, maxHeight int) []flowLine {
+	var lines []flowLine
+	current := flowLine{}
+
+	for i, item := range f.items {
+		itemWidth, itemHeight := MeasureComponent(item, maxWidth, maxHeight)
+
+		// Wrap when the item doesn't fit after the current line's items
+		if len(current.items) > 0 && current.width+f.spacing+itemWidth > maxWidth {
+			lines = append(lines, current)
+			current = flowLine{}
+		}
+
+		if len(current.items) > 0 {
+			current.width += f.spacing
+		}
+		current.items = append(current.items, i)
+		current.widths = append(current.widths, itemWidth)
+		current.heights = append(current.heights, itemHeight)
+		current.width += itemWidth
+		current.height = max(current.height, itemHeight)
+	}
+
+	if len(current.items) > 0 {
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+// Draw renders the flow layout to the screen
+func (f *Flow) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	// Flow decides to use available space for layout
+	f.width = availableWidth
+	f.height = availableHeight
+
+	if len(f.items) == 0 {
+		return
+	}
+
+	ClearComponentArea(screen, x, y, availableWidth, availableHeight, theme)
+
+	contentRect := ApplyMargin(Rectangle{X: x, Y: y, Width: availableWidth, Height: availableHeight}, f.padding)
+	if contentRect.Width <= 0 || contentRect.Height <= 0 {
+		return
+	}
+	bottom := contentRect.Y + contentRect.Height
+
+	lineY := contentRect.Y
+	for _, line := range f.layoutLines(contentRect.Width, contentRect.Height) {
+		if lineY >= bottom {
+			break
+		}
+
+		// Distribute the line's free space according to justify
+		free := contentRect.Width - line.width
+		if free < 0 {
+			free = 0
+		}
+		currentX := contentRect.X
+		gap := f.spacing
+		switch f.justify {
+		case JustifyCenter:
+			currentX += free / 2
+		case JustifyEnd:
+			currentX += free
+		case JustifySpaceBetween:
+			if len(line.items) > 1 {
+				gap += free / (len(line.items) - 1)
+			}
+		}
+		extra := 0
+		if f.justify == JustifySpaceBetween && len(line.items) > 1 {
+			extra = free % (len(line.items) - 1)
+		}
+
+		for i, index := range line.items {
+			itemWidth := line.widths[i]
+			itemHeight := line.heights[i]
+			itemY := lineY
+
+			switch f.alignItems {
+			case AlignCenter:
+				itemY += (line.height - itemHeight) / 2
+			case AlignEnd:
+				itemY += line.height - itemHeight
+			case AlignStretch:
+				itemHeight = line.height
+			}
+
+			// Keep items inside the layout
+			itemWidth = min(itemWidth, contentRect.X+contentRect.Width-currentX)
+			itemHeight = min(itemHeight, bottom-itemY)
+
+			screen.DrawComponent(f.items[index], currentX, itemY, itemWidth, itemHeight, theme)
+
+			currentX += line.widths[i] + gap
+			// Spread the remainder over the first gaps
+			if i < extra {
+				currentX++
+			}
+		}
+
+		lineY += line.height + f.lineSpacing
+	}
+}
+
+// Measure returns the size needed to fit all lines when wrapped to maxWidth
+func (f *Flow) Measure(maxWidth, maxHeight int) (width, height int) {
+	innerWidth := maxWidth - f.padding.Left - f.padding.Right
+	innerHeight := maxHeight - f.padding.Top - f.padding.Bottom
+
+	lines := f.layoutLines(innerWidth, innerHeight)
+	for i, line := range lines {
+		if i > 0 {
+			height += f.lineSpacing
+		}
+		width = max(width, line.width)
+		height += line.height
+	}
+	return width + f.padding.Left + f.padding.Right, height + f.padding.Top + f.padding.Bottom
+}
+
+// Clear removes all items from the layout
+func (f *Flow) Clear() {
+	f.items = []Component{}
+}
+
+// HandleInput processes keyboard input
+func (f *Flow) HandleInput(key string) {
+	// Flow doesn't handle input itself
+}
+
+// Count returns the number of items in the layout
+func (f *Flow) Count() int {
+	return len(f.items)
+}
+
+// GetItem returns the component at the specified index
+func (f *Flow) GetItem(index int) Component {
+	if index >= 0 && index < len(f.items) {
+		return f.items[index]
+	}
+	return nil
+}
+
+// Component interface methods
+
+// Focus gives keyboard focus to this component
+func (f *Flow) Focus() {
+	// Focus first focusable item
+	focusFirst(f.items)
+}
+
+// Blur removes keyboard focus from this component
+func (f *Flow) Blur() {
+	// Blur all items
+	blurAll(f.items)
+}
+
+// IsFocused returns whether this component currently has focus
+func (f *Flow) IsFocused() bool {
+	// Check if any item is focused
+	return anyFocused(f.items)
+}
+
+// HandleKey processes keyboard input when focused
+func (f *Flow) HandleKey(key string) bool {
+	// Pass to focused item
+	return handleKeyFocused(f.items, key)
+}
+
+// ForwardsFocus reports that the layout only passes focus on to its
+// children
+func (f *Flow) ForwardsFocus() bool {
+	return true
+}
+
+// Children returns the components in the layout
+func (f *Flow) Children() []Component {
+	return f.items
+}
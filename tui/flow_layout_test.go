@@ -0,0 +1,136 @@
+package tui
+
+import (
+	"testing"
+)
+
+// sizedComponent is a mock component with a fixed preferred size
+type sizedComponent struct {
+	mockComponent
+	prefWidth  int
+	prefHeight int
+}
+
+func newSizedComponent(width, height int) *sizedComponent {
+	return &sizedComponent{prefWidth: width, prefHeight: height}
+}
+
+func (s *sizedComponent) Measure(maxWidth, maxHeight int) (width, height int) {
+	return s.prefWidth, s.prefHeight
+}
+
+// drawnRect returns where the mock was drawn on the root screen
+func drawnRect(screen *Screen, component Component) (Rectangle, bool) {
+	for _, drawn := range screen.DrawnRects() {
+		if drawn.Component == component {
+			return drawn.Rect, true
+		}
+	}
+	return Rectangle{}, false
+}
+
+func TestFlowWrapsItems(t *testing.T) {
+	screen := NewScreenSimulation(20, 10)
+	screen.SetRectTracking(true)
+	theme := NewTestTheme()
+
+	a := newSizedComponent(8, 1)
+	b := newSizedComponent(8, 1)
+	c := newSizedComponent(8, 1)
+
+	flow := NewFlow()
+	flow.Add(a)
+	flow.Add(b)
+	flow.Add(c)
+	flow.Draw(screen.Screen, 0, 0, 20, 10, theme)
+
+	expected := map[*sizedComponent]Rectangle{
+		a: {X: 0, Y: 0, Width: 8, Height: 1},
+		b: {X: 9, Y: 0, Width: 8, Height: 1},
+		c: {X: 0, Y: 1, Width: 8, Height: 1},
+	}
+	for component, want := range expected {
+		if got, ok := drawnRect(screen.Screen, component); !ok || got != want {
+			t.Errorf("Expected %+v, got %+v", want, got)
+		}
+	}
+}
+
+func TestFlowJustify(t *testing.T) {
+	tests := []struct {
+		name    string
+		justify Justify
+		firstX  int
+		secondX int
+	}{
+		{"start", JustifyStart, 0, 5},
+		{"center", JustifyCenter, 5, 10},
+		{"end", JustifyEnd, 11, 16},
+		{"space between", JustifySpaceBetween, 0, 16},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			screen := NewScreenSimulation(20, 5)
+			screen.SetRectTracking(true)
+			theme := NewTestTheme()
+
+			first := newSizedComponent(4, 1)
+			second := newSizedComponent(4, 1)
+
+			flow := NewFlow()
+			flow.SetJustify(tt.justify)
+			flow.Add(first)
+			flow.Add(second)
+			flow.Draw(screen.Screen, 0, 0, 20, 5, theme)
+
+			if rect, _ := drawnRect(screen.Screen, first); rect.X != tt.firstX {
+				t.Errorf("Expected first item at x=%d, got %d", tt.firstX, rect.X)
+			}
+			if rect, _ := drawnRect(screen.Screen, second); rect.X != tt.secondX {
+				t.Errorf("Expected second item at x=%d, got %d", tt.secondX, rect.X)
+			}
+		})
+	}
+}
+
+func TestFlowAlignItems(t *testing.T) {
+	screen := NewScreenSimulation(20, 5)
+	screen.SetRectTracking(true)
+	theme := NewTestTheme()
+
+	tall := newSizedComponent(4, 3)
+	short := newSizedComponent(4, 1)
+
+	flow := NewFlow()
+	flow.SetAlignItems(AlignEnd)
+	flow.Add(tall)
+	flow.Add(short)
+	flow.Draw(screen.Screen, 0, 0, 20, 5, theme)
+
+	if rect, _ := drawnRect(screen.Screen, short); rect.Y != 2 {
+		t.Errorf("Expected short item at y=2, got %d", rect.Y)
+	}
+
+	flow.SetAlignItems(AlignStretch)
+	screen.Clear()
+	flow.Draw(screen.Screen, 0, 0, 20, 5, theme)
+
+	if rect, _ := drawnRect(screen.Screen, short); rect.Height != 3 {
+		t.Errorf("Expected stretched height 3, got %d", rect.Height)
+	}
+}
+
+func TestFlowMeasure(t *testing.T) {
+	flow := NewFlow()
+	flow.SetLineSpacing(1)
+	for i := 0; i < 5; i++ {
+		flow.Add(newSizedComponent(5, 1))
+	}
+
+	// Three items fit per line at width 17 (5+1+5+1+5)
+	width, height := flow.Measure(17, 100)
+	if width != 17 || height != 3 {
+		t.Errorf("Expected 17x3, got %dx%d", width, height)
+	}
+}
@@ -1,11 +1,13 @@
 package tui
 
+import "reflect"
 
 // FocusManager manages focus state between multiple components
 type FocusManager struct {
 	components []FocusableComponent
 	current    int
 	wrapAround bool
+	rects      map[string]Rectangle // Where each component was last drawn
 }
 
 // FocusableComponent pairs a component with its identifier
@@ -20,6 +22,7 @@ func NewFocusManager() *FocusManager {
 		components: []FocusableComponent{},
 		current:    -1,
 		wrapAround: true,
+		rects:      make(map[string]Rectangle),
 	}
 }
 
@@ -46,6 +49,8 @@ func (fm *FocusManager) Remove(id string) {
 				fc.Component.Blur()
 			}
 
+			delete(fm.rects, id)
+
 			// Remove from slice
 			fm.components = append(fm.components[:i], fm.components[i+1:]...)
 
@@ -145,6 +150,9 @@ func (fm *FocusManager) HandleKey(key string) bool {
 		fm.FocusPrevious()
 		return true
 	}
+	if direction, ok := focusDirectionForKey(key); ok {
+		return fm.FocusInDirection(direction)
+	}
 	return false
 }
 
@@ -157,6 +165,7 @@ func (fm *FocusManager) Clear() {
 
 	fm.components = []FocusableComponent{}
 	fm.current = -1
+	fm.rects = make(map[string]Rectangle)
 }
 
 // setFocus is an internal method to change focus
@@ -175,6 +184,158 @@ func (fm *FocusManager) setFocus(index int) {
 	fm.components[fm.current].Component.Focus()
 }
 
+// SetRect sets where a component was last drawn, for directional navigation
+func (fm *FocusManager) SetRect(id string, rect Rectangle) {
+	fm.rects[id] = rect
+}
+
+// GetRect returns where a component was last drawn
+func (fm *FocusManager) GetRect(id string) (Rectangle, bool) {
+	rect, ok := fm.rects[id]
+	return rect, ok
+}
+
+// UpdateRects records where each registered component was last drawn
+// Pass screen.DrawnRects() after drawing with rect tracking enabled.
+// Components that weren't drawn lose their rectangle, so hidden components
+// are skipped by directional navigation.
+func (fm *FocusManager) UpdateRects(rects []ComponentRect) {
+	fm.rects = make(map[string]Rectangle)
+	for _, fc := range fm.components {
+		// The last record wins if a component was drawn more than once
+		for i := len(rects) - 1; i >= 0; i-- {
+			if sameComponent(rects[i].Component, fc.Component) {
+				fm.rects[fc.ID] = rects[i].Rect
+				break
+			}
+		}
+	}
+}
+
+// FocusInDirection moves focus to the nearest component in a direction,
+// based on where each component was last drawn
+// Returns false if nothing was found in that direction.
+func (fm *FocusManager) FocusInDirection(direction FocusDirection) bool {
+	id, _ := fm.GetFocused()
+	from, ok := fm.rects[id]
+	if !ok {
+		return false
+	}
+
+	var candidates []Rectangle
+	var indices []int
+	for i, fc := range fm.components {
+		if rect, ok := fm.rects[fc.ID]; ok && i != fm.current {
+			candidates = append(candidates, rect)
+			indices = append(indices, i)
+		}
+	}
+
+	nearest := nearestInDirection(from, candidates, direction)
+	if nearest < 0 {
+		return false
+	}
+	fm.setFocus(indices[nearest])
+	return true
+}
+
+// FocusDirection is a direction for spatial focus navigation
+type FocusDirection int
+
+const (
+	FocusLeft FocusDirection = iota
+	FocusRight
+	FocusUp
+	FocusDown
+)
+
+// focusDirectionForKey maps alt+arrow keys to focus directions
+func focusDirectionForKey(key string) (FocusDirection, bool) {
+	switch key {
+	case "alt+left":
+		return FocusLeft, true
+	case "alt+right":
+		return FocusRight, true
+	case "alt+up":
+		return FocusUp, true
+	case "alt+down":
+		return FocusDown, true
+	}
+	return 0, false
+}
+
+// nearestInDirection returns the index of the candidate nearest to from in
+// a direction, or -1 if there is none
+// Candidates that line up with from (overlap across the direction of travel)
+// win over ones that don't, like moving between tmux or vim panes. Ties go to
+// the closest centre and then to the earliest candidate.
+func nearestInDirection(from Rectangle, candidates []Rectangle, direction FocusDirection) int {
+	best := -1
+	var bestScore [3]int
+
+	for i, rect := range candidates {
+		// Distance along the direction of travel; the candidate must lie
+		// entirely beyond the edge of from
+		var distance int
+		var fromStart, fromEnd, start, end int
+		switch direction {
+		case FocusLeft:
+			distance = from.X - (rect.X + rect.Width)
+			fromStart, fromEnd, start, end = from.Y, from.Y+from.Height, rect.Y, rect.Y+rect.Height
+		case FocusRight:
+			distance = rect.X - (from.X + from.Width)
+			fromStart, fromEnd, start, end = from.Y, from.Y+from.Height, rect.Y, rect.Y+rect.Height
+		case FocusUp:
+			distance = from.Y - (rect.Y + rect.Height)
+			fromStart, fromEnd, start, end = from.X, from.X+from.Width, rect.X, rect.X+rect.Width
+		case FocusDown:
+			distance = rect.Y - (from.Y + from.Height)
+			fromStart, fromEnd, start, end = from.X, from.X+from.Width, rect.X, rect.X+rect.Width
+		}
+		if distance < 0 || rect.Width <= 0 || rect.Height <= 0 {
+			continue
+		}
+
+		// Gap across the direction of travel, zero when the spans overlap
+		gap := 0
+		if start >= fromEnd {
+			gap = start - fromEnd + 1
+		} else if end <= fromStart {
+			gap = fromStart - end + 1
+		}
+		aligned := 0
+		if gap > 0 {
+			aligned = 1
+		}
+		centre := (start + end) - (fromStart + fromEnd)
+		if centre < 0 {
+			centre = -centre
+		}
+
+		score := [3]int{aligned, distance + gap, centre}
+		if best < 0 || score[0] < bestScore[0] ||
+			(score[0] == bestScore[0] && (score[1] < bestScore[1] ||
+				(score[1] == bestScore[1] && score[2] < bestScore[2]))) {
+			best = i
+			bestScore = score
+		}
+	}
+	return best
+}
+
+// sameComponent reports whether two values are the same component,
+// without panicking on component types that can't be compared
+func sameComponent(a, b any) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	typeA := reflect.TypeOf(a)
+	if typeA != reflect.TypeOf(b) || !typeA.Comparable() {
+		return false
+	}
+	return a == b
+}
+
 // FocusGroup manages multiple focus managers for complex layouts
 type FocusGroup struct {
 	managers   map[string]*FocusManager
@@ -208,8 +369,67 @@ func (fg *FocusGroup) SwitchTo(id string) {
 	}
 }
 
+// UpdateRects records where each component in every manager was last drawn
+func (fg *FocusGroup) UpdateRects(rects []ComponentRect) {
+	for _, id := range fg.groupOrder {
+		fg.managers[id].UpdateRects(rects)
+	}
+}
+
+// FocusInDirection moves focus to the nearest component in a direction,
+// switching to another manager if that's where the nearest component is
+func (fg *FocusGroup) FocusInDirection(direction FocusDirection) bool {
+	active, exists := fg.managers[fg.activeID]
+	if !exists {
+		return false
+	}
+	id, _ := active.GetFocused()
+	from, ok := active.rects[id]
+	if !ok {
+		return false
+	}
+
+	type target struct {
+		managerID string
+		index     int
+	}
+	var candidates []Rectangle
+	var targets []target
+	for _, managerID := range fg.groupOrder {
+		manager := fg.managers[managerID]
+		for i, fc := range manager.components {
+			if managerID == fg.activeID && i == manager.current {
+				continue
+			}
+			if rect, ok := manager.rects[fc.ID]; ok {
+				candidates = append(candidates, rect)
+				targets = append(targets, target{managerID, i})
+			}
+		}
+	}
+
+	nearest := nearestInDirection(from, candidates, direction)
+	if nearest < 0 {
+		return false
+	}
+	found := targets[nearest]
+	if found.managerID != fg.activeID {
+		// Only one component in the group holds focus at a time
+		if _, focused := active.GetFocused(); focused != nil {
+			focused.Blur()
+		}
+		fg.activeID = found.managerID
+	}
+	fg.managers[found.managerID].setFocus(found.index)
+	return true
+}
+
 // HandleKey routes key events to the active focus manager
+// Alt+arrow keys move focus spatially across all managers in the group.
 func (fg *FocusGroup) HandleKey(key string) bool {
+	if direction, ok := focusDirectionForKey(key); ok {
+		return fg.FocusInDirection(direction)
+	}
 	if manager, exists := fg.managers[fg.activeID]; exists {
 		return manager.HandleKey(key)
 	}
@@ -0,0 +1,161 @@
+package tui
+
+import "testing"
+
+// drawPaneGrid draws a 2x2 grid of panes with a wide pane underneath:
+//
+//	+------+------+
+//	| a    | b    |
+//	+------+------+
+//	| c    | d    |
+//	+------+------+
+//	| e           |
+//	+-------------+
+func drawPaneGrid(screen *Screen, panes map[string]*mockComponent) {
+	top := HBox()
+	top.AddFlex(panes["a"], 1)
+	top.AddFlex(panes["b"], 1)
+
+	middle := HBox()
+	middle.AddFlex(panes["c"], 1)
+	middle.AddFlex(panes["d"], 1)
+
+	root := VBox()
+	root.AddFlex(top, 1)
+	root.AddFlex(middle, 1)
+	root.AddFlex(panes["e"], 1)
+
+	screen.SetRectTracking(true)
+	screen.Clear()
+	screen.DrawComponent(root, 0, 0, 40, 12, NewTestTheme())
+}
+
+func newPanes() map[string]*mockComponent {
+	panes := map[string]*mockComponent{}
+	for _, id := range []string{"a", "b", "c", "d", "e"} {
+		panes[id] = &mockComponent{id: id}
+	}
+	return panes
+}
+
+func TestFocusManagerSpatialNavigation(t *testing.T) {
+	screen := NewScreenSimulation(40, 12).Screen
+	panes := newPanes()
+
+	fm := NewFocusManager()
+	for _, id := range []string{"a", "b", "c", "d", "e"} {
+		fm.Add(id, panes[id])
+	}
+	drawPaneGrid(screen, panes)
+	fm.UpdateRects(screen.DrawnRects())
+
+	steps := []struct {
+		key      string
+		expected string
+	}{
+		{"alt+right", "b"},
+		{"alt+down", "d"},
+		{"alt+left", "c"},
+		{"alt+down", "e"},
+		{"alt+up", "c"},
+		{"alt+up", "a"},
+	}
+	for _, step := range steps {
+		if !fm.HandleKey(step.key) {
+			t.Fatalf("Expected %s to be handled", step.key)
+		}
+		if id := fm.GetFocusedID(); id != step.expected {
+			t.Fatalf("Expected %s to focus %s, got %s", step.key, step.expected, id)
+		}
+	}
+
+	// Nothing further left or up
+	if fm.HandleKey("alt+left") || fm.HandleKey("alt+up") {
+		t.Error("Expected no movement past the edge")
+	}
+	if !panes["a"].focused || panes["c"].focused {
+		t.Error("Expected only pane a to be focused")
+	}
+}
+
+func TestFocusManagerSpatialSkipsUndrawn(t *testing.T) {
+	screen := NewScreenSimulation(40, 12).Screen
+	panes := newPanes()
+
+	fm := NewFocusManager()
+	fm.Add("a", panes["a"])
+	fm.Add("b", panes["b"])
+	fm.Add("hidden", &mockComponent{id: "hidden"})
+	drawPaneGrid(screen, panes)
+	fm.UpdateRects(screen.DrawnRects())
+
+	if _, ok := fm.GetRect("hidden"); ok {
+		t.Error("Expected no rect for a component that wasn't drawn")
+	}
+
+	// A component drawn outside tracking can be placed by hand
+	fm.SetRect("hidden", Rectangle{X: 0, Y: 4, Width: 20, Height: 4})
+	fm.HandleKey("alt+down")
+	if id := fm.GetFocusedID(); id != "hidden" {
+		t.Errorf("Expected hidden to be focused, got %s", id)
+	}
+}
+
+func TestFocusGroupSpatialNavigationAcrossManagers(t *testing.T) {
+	screen := NewScreenSimulation(40, 12).Screen
+	panes := newPanes()
+
+	left := NewFocusManager()
+	left.Add("a", panes["a"])
+	left.Add("c", panes["c"])
+	right := NewFocusManager()
+	right.Add("b", panes["b"])
+	right.Add("d", panes["d"])
+
+	group := NewFocusGroup()
+	group.AddManager("left", left)
+	group.AddManager("right", right)
+	right.Focus("d")
+	panes["b"].Blur()
+	panes["d"].Blur()
+
+	drawPaneGrid(screen, panes)
+	group.UpdateRects(screen.DrawnRects())
+
+	left.Focus("c")
+	if !group.HandleKey("alt+right") {
+		t.Fatal("Expected alt+right to be handled")
+	}
+	if !panes["d"].focused || panes["c"].focused {
+		t.Error("Expected focus to move from c to d")
+	}
+
+	// Tab now cycles within the manager that received focus
+	group.HandleKey("tab")
+	if !panes["b"].focused {
+		t.Error("Expected tab to cycle within the right manager")
+	}
+
+	group.HandleKey("alt+left")
+	if !panes["a"].focused || panes["b"].focused {
+		t.Error("Expected focus to move back to a")
+	}
+}
+
+func TestNearestInDirectionPrefersAligned(t *testing.T) {
+	from := Rectangle{X: 0, Y: 5, Width: 10, Height: 2}
+	candidates := []Rectangle{
+		{X: 12, Y: 0, Width: 5, Height: 2},  // Closer but above
+		{X: 30, Y: 5, Width: 5, Height: 2},  // Further but in line
+		{X: -20, Y: 5, Width: 5, Height: 2}, // Wrong direction
+	}
+	if nearest := nearestInDirection(from, candidates, FocusRight); nearest != 1 {
+		t.Errorf("Expected the aligned candidate, got %d", nearest)
+	}
+	if nearest := nearestInDirection(from, candidates, FocusLeft); nearest != 2 {
+		t.Errorf("Expected the candidate on the left, got %d", nearest)
+	}
+	if nearest := nearestInDirection(from, candidates, FocusDown); nearest != -1 {
+		t.Errorf("Expected nothing below, got %d", nearest)
+	}
+}
@@ -0,0 +1,374 @@
+package tui
+
+import "sort"
+
+// Composite is implemented by components that contain other components
+// Children returns only the children currently shown; hidden or inactive
+// branches (such as a Conditional's unselected components) are left out so
+// focus skips them.
+type Composite interface {
+	Children() []Component
+}
+
+// FocusForwarder is implemented by composites that only pass focus on to
+// their children, such as layouts
+// They're never focus targets themselves, so one with nothing focusable
+// inside is skipped rather than becoming an empty tab stop. Other
+// composites, like a ScrollView of plain text, are targets when nothing
+// inside them is.
+type FocusForwarder interface {
+	ForwardsFocus() bool
+}
+
+// TabIndexer lets a component override its place in tab order
+// Components with a positive index come first, in ascending order, followed
+// by those with index 0 in tree order. A negative index removes the
+// component from tab order.
+type TabIndexer interface {
+	TabIndex() int
+}
+
+// FocusTree builds tab order automatically by walking a component tree
+//
+// Focus targets are Focusable components that have no focusable descendants;
+// Composite components are walked into, and FocusForwarder layouts are
+// never targets themselves. The order is rediscovered on every
+// navigation, so it stays in sync with what is displayed.
+type FocusTree struct {
+	root       Component
+	order      []Focusable
+	current    Focusable
+	tabIndexes []tabIndexOverride
+	rects      []ComponentRect
+	wrapAround bool
+}
+
+// tabIndexOverride is a tab index set with FocusTree.SetTabIndex
+type tabIndexOverride struct {
+	component Component
+	index     int
+}
+
+// NewFocusTree creates a focus tree rooted at a component and focuses the
+// first component in tab order
+func NewFocusTree(root Component) *FocusTree {
+	ft := &FocusTree{
+		root:       root,
+		wrapAround: true,
+	}
+	ft.Refresh()
+	return ft
+}
+
+// SetRoot sets the root of the component tree
+func (ft *FocusTree) SetRoot(root Component) {
+	ft.root = root
+	ft.Refresh()
+}
+
+// SetWrapAround sets whether focus should wrap around at the ends
+func (ft *FocusTree) SetWrapAround(wrap bool) {
+	ft.wrapAround = wrap
+}
+
+// SetTabIndex overrides a component's place in tab order
+// It takes precedence over the component's own TabIndexer.
+func (ft *FocusTree) SetTabIndex(component Component, index int) {
+	for i, override := range ft.tabIndexes {
+		if sameComponent(override.component, component) {
+			ft.tabIndexes[i].index = index
+			return
+		}
+	}
+	ft.tabIndexes = append(ft.tabIndexes, tabIndexOverride{component, index})
+}
+
+// tabIndex returns the tab index of a component
+func (ft *FocusTree) tabIndex(component Component) int {
+	for _, override := range ft.tabIndexes {
+		if sameComponent(override.component, component) {
+			return override.index
+		}
+	}
+	if indexer, ok := component.(TabIndexer); ok {
+		return indexer.TabIndex()
+	}
+	return 0
+}
+
+// Refresh rediscovers the focus targets
+// If the focused component is no longer shown, it is blurred and focus moves
+// to the first component in tab order.
+func (ft *FocusTree) Refresh() {
+	type entry struct {
+		focusable Focusable
+		index     int
+	}
+	var entries []entry
+	for _, component := range FocusTargets(ft.root) {
+		index := ft.tabIndex(component)
+		if index < 0 {
+			continue
+		}
+		entries = append(entries, entry{component.(Focusable), index})
+	}
+
+	// Positive indexes first, then tree order
+	sort.SliceStable(entries, func(a, b int) bool {
+		indexA, indexB := entries[a].index, entries[b].index
+		if indexA > 0 && indexB > 0 {
+			return indexA < indexB
+		}
+		return indexA > 0 && indexB == 0
+	})
+
+	ft.order = make([]Focusable, len(entries))
+	for i, e := range entries {
+		ft.order[i] = e.focusable
+	}
+
+	if ft.indexOf(ft.current) < 0 {
+		if ft.current != nil {
+			ft.current.Blur()
+			ft.current = nil
+		}
+		if len(ft.order) > 0 {
+			ft.setFocus(ft.order[0])
+		}
+	}
+}
+
+// Order returns the focus targets in tab order
+func (ft *FocusTree) Order() []Focusable {
+	ft.Refresh()
+	return ft.order
+}
+
+// Focused returns the focused component, or nil
+func (ft *FocusTree) Focused() Focusable {
+	return ft.current
+}
+
+// Focus moves focus to a component in the tree
+// Returns false if the component isn't a focus target.
+func (ft *FocusTree) Focus(component Focusable) bool {
+	ft.Refresh()
+	if ft.indexOf(component) < 0 {
+		return false
+	}
+	ft.setFocus(component)
+	return true
+}
+
+// FocusNext moves focus to the next component in tab order
+func (ft *FocusTree) FocusNext() {
+	ft.step(1)
+}
+
+// FocusPrevious moves focus to the previous component in tab order
+func (ft *FocusTree) FocusPrevious() {
+	ft.step(-1)
+}
+
+// step moves focus by delta places in tab order
+func (ft *FocusTree) step(delta int) {
+	ft.Refresh()
+	if len(ft.order) == 0 {
+		return
+	}
+
+	index := ft.indexOf(ft.current) + delta
+	if index < 0 || index >= len(ft.order) {
+		if !ft.wrapAround {
+			return
+		}
+		index = (index + len(ft.order)) % len(ft.order)
+	}
+	ft.setFocus(ft.order[index])
+}
+
+// UpdateRects records where components were last drawn, for directional
+// navigation
+// Pass screen.DrawnRects() after drawing with rect tracking enabled.
+func (ft *FocusTree) UpdateRects(rects []ComponentRect) {
+	ft.rects = rects
+}
+
+// rectOf returns where a component was last drawn
+func (ft *FocusTree) rectOf(component Focusable) (Rectangle, bool) {
+	for i := len(ft.rects) - 1; i >= 0; i-- {
+		if sameComponent(ft.rects[i].Component, component) {
+			return ft.rects[i].Rect, true
+		}
+	}
+	return Rectangle{}, false
+}
+
+// FocusInDirection moves focus to the nearest component in a direction
+// Returns false if nothing was found in that direction.
+func (ft *FocusTree) FocusInDirection(direction FocusDirection) bool {
+	ft.Refresh()
+	from, ok := ft.rectOf(ft.current)
+	if !ok {
+		return false
+	}
+
+	var candidates []Rectangle
+	var targets []Focusable
+	for _, focusable := range ft.order {
+		if sameComponent(focusable, ft.current) {
+			continue
+		}
+		if rect, ok := ft.rectOf(focusable); ok {
+			candidates = append(candidates, rect)
+			targets = append(targets, focusable)
+		}
+	}
+
+	nearest := nearestInDirection(from, candidates, direction)
+	if nearest < 0 {
+		return false
+	}
+	ft.setFocus(targets[nearest])
+	return true
+}
+
+// HandleKey moves focus with tab, shift+tab and alt+arrow keys and passes
+// other keys to the focused component's HandleKey, or HandleInput if it has
+// none
+// Returns true if the key was handled
+func (ft *FocusTree) HandleKey(key string) bool {
+	switch key {
+	case "tab":
+		ft.FocusNext()
+		return true
+	case "shift+tab":
+		ft.FocusPrevious()
+		return true
+	}
+	if direction, ok := focusDirectionForKey(key); ok {
+		return ft.FocusInDirection(direction)
+	}
+
+	if handler, ok := ft.current.(interface{ HandleKey(string) bool }); ok {
+		return handler.HandleKey(key)
+	}
+	if component, ok := ft.current.(Component); ok {
+		component.HandleInput(key)
+		return true
+	}
+	return false
+}
+
+// indexOf returns the position of a component in tab order, or -1
+func (ft *FocusTree) indexOf(component Focusable) int {
+	if component == nil {
+		return -1
+	}
+	for i, focusable := range ft.order {
+		if sameComponent(focusable, component) {
+			return i
+		}
+	}
+	return -1
+}
+
+// setFocus blurs the focused component and focuses another
+func (ft *FocusTree) setFocus(component Focusable) {
+	if ft.current != nil && !sameComponent(ft.current, component) {
+		ft.current.Blur()
+	}
+	ft.current = component
+	component.Focus()
+}
+
+// FocusTargets returns the focusable components in a tree, in tree order
+// Composite components are walked into and are targets themselves only when
+// nothing inside them is and they don't forward focus (see FocusForwarder).
+// Hidden components (those with
+// an IsVisible method returning false) are skipped along with their children.
+// Disabled components (those with an IsDisabled method returning true) are
+// never targets themselves.
+func FocusTargets(root Component) []Component {
+	var targets []Component
+	var walk func(component Component)
+	walk = func(component Component) {
+		if component == nil {
+			return
+		}
+		if visible, ok := component.(interface{ IsVisible() bool }); ok && !visible.IsVisible() {
+			return
+		}
+
+		before := len(targets)
+		if composite, ok := component.(Composite); ok {
+			for _, child := range composite.Children() {
+				walk(child)
+			}
+		}
+
+		if disabled, ok := component.(interface{ IsDisabled() bool }); ok && disabled.IsDisabled() {
+			return
+		}
+		if forwarder, ok := component.(FocusForwarder); ok && forwarder.ForwardsFocus() {
+			return
+		}
+		// A focusable component is a target unless something inside it is
+		if _, ok := component.(Focusable); ok && len(targets) == before {
+			targets = append(targets, component)
+		}
+	}
+	walk(root)
+	return targets
+}
+
+// focusFirst focuses the first focusable component
+func focusFirst(components []Component) {
+	for _, component := range components {
+		if focusable, ok := component.(Focusable); ok {
+			focusable.Focus()
+			return
+		}
+	}
+}
+
+// blurAll blurs every focusable component
+func blurAll(components []Component) {
+	for _, component := range components {
+		if focusable, ok := component.(Focusable); ok {
+			focusable.Blur()
+		}
+	}
+}
+
+// anyFocused returns whether any of the components has focus
+func anyFocused(components []Component) bool {
+	for _, component := range components {
+		if focusable, ok := component.(Focusable); ok && focusable.IsFocused() {
+			return true
+		}
+	}
+	return false
+}
+
+// handleKeyFocused passes a key to the first focused component that
+// handles keys
+func handleKeyFocused(components []Component, key string) bool {
+	for _, component := range components {
+		if handler, ok := component.(interface{ HandleKey(string) bool }); ok {
+			if focusable, ok := component.(Focusable); ok && focusable.IsFocused() {
+				return handler.HandleKey(key)
+			}
+		}
+	}
+	return false
+}
+
+// reversed returns the components in reverse order
+func reversed(components []Component) []Component {
+	result := make([]Component, len(components))
+	for i, component := range components {
+		result[len(components)-1-i] = component
+	}
+	return result
+}
@@ -0,0 +1,230 @@
+package tui
+
+import "testing"
+
+// tabIndexed is a mock component with its own tab index
+type tabIndexed struct {
+	mockComponent
+	index int
+}
+
+func (t *tabIndexed) TabIndex() int {
+	return t.index
+}
+
+// focusedIDs returns the ids of mock components in tab order
+func focusedIDs(order []Focusable) []string {
+	ids := make([]string, len(order))
+	for i, focusable := range order {
+		switch component := focusable.(type) {
+		case *mockComponent:
+			ids[i] = component.id
+		case *tabIndexed:
+			ids[i] = component.id
+		default:
+			ids[i] = componentTypeName(focusable.(Component))
+		}
+	}
+	return ids
+}
+
+func assertOrder(t *testing.T, order []Focusable, expected ...string) {
+	t.Helper()
+	ids := focusedIDs(order)
+	if len(ids) != len(expected) {
+		t.Fatalf("Expected order %v, got %v", expected, ids)
+	}
+	for i := range ids {
+		if ids[i] != expected[i] {
+			t.Fatalf("Expected order %v, got %v", expected, ids)
+		}
+	}
+}
+
+func TestFocusTreeDiscoversOrder(t *testing.T) {
+	a, b, c, d := &mockComponent{id: "a"}, &mockComponent{id: "b"}, &mockComponent{id: "c"}, &mockComponent{id: "d"}
+
+	sidebar := VBox()
+	sidebar.AddFlex(a, 1)
+	sidebar.AddFlex(b, 1)
+
+	container := NewContainer()
+	container.SetContent(d)
+
+	root := NewSplit(false)
+	root.SetFirst(sidebar)
+	root.SetSecond(HBox())
+	root.second.(*LinearLayout).AddFlex(c, 1)
+	root.second.(*LinearLayout).AddFlex(container, 1)
+
+	tree := NewFocusTree(root)
+	assertOrder(t, tree.Order(), "a", "b", "c", "d")
+	if !a.focused {
+		t.Error("Expected the first component to be focused")
+	}
+
+	tree.HandleKey("tab")
+	tree.HandleKey("tab")
+	tree.HandleKey("tab")
+	if !d.focused || a.focused || b.focused || c.focused {
+		t.Error("Expected only d to be focused after three tabs")
+	}
+	if !container.hasFocus() {
+		t.Error("Expected the container to show focus of its content")
+	}
+
+	tree.HandleKey("tab")
+	if !a.focused || d.focused {
+		t.Error("Expected focus to wrap around to a")
+	}
+
+	tree.HandleKey("shift+tab")
+	if !d.focused {
+		t.Error("Expected shift+tab to wrap back to d")
+	}
+}
+
+func TestFocusTreeSkipsInactiveBranches(t *testing.T) {
+	wide := &mockComponent{id: "wide"}
+	narrow := &mockComponent{id: "narrow"}
+
+	conditional := NewConditional()
+	conditional.AddMinSize(wide, 80, 0)
+	conditional.SetFallback(narrow)
+	conditional.SetSize(100, 20)
+
+	other := &mockComponent{id: "other"}
+	root := VBox()
+	root.AddFlex(conditional, 1)
+	root.AddFlex(other, 1)
+
+	tree := NewFocusTree(root)
+	assertOrder(t, tree.Order(), "wide", "other")
+
+	// The wide branch is focused, then hidden by a resize
+	conditional.SetSize(40, 20)
+	assertOrder(t, tree.Order(), "narrow", "other")
+	if wide.focused {
+		t.Error("Expected the hidden branch to be blurred")
+	}
+	if !narrow.focused {
+		t.Error("Expected focus to move to the first shown component")
+	}
+
+	// Hidden modals are skipped too
+	modal := NewModal()
+	modal.Hide()
+	root.AddFlex(modal, 1)
+	assertOrder(t, tree.Order(), "narrow", "other")
+}
+
+func TestFocusTreeSkipsEmptyComposites(t *testing.T) {
+	input := &mockComponent{id: "input"}
+
+	status := VBox()
+	status.AddFixed(NewStatusBar(), 1)
+	container := NewContainer()
+	container.SetContent(NewFlow())
+
+	root := VBox()
+	root.AddFlex(input, 1)
+	root.AddFixed(status, 1)
+	root.AddFlex(container, 1)
+
+	// Layouts with nothing focusable inside aren't tab stops
+	tree := NewFocusTree(root)
+	assertOrder(t, tree.Order(), "input")
+}
+
+func TestFocusTreeWalksIntoScrollViewsAndTabs(t *testing.T) {
+	name, email := &mockComponent{id: "name"}, &mockComponent{id: "email"}
+	fields := VBox()
+	fields.AddFixed(name, 1)
+	fields.AddFixed(email, 1)
+	scroll := NewScrollView()
+	scroll.SetContent(fields)
+
+	// A scroll view of plain text is a tab stop itself, since it scrolls
+	notes := NewScrollView()
+	notes.SetContent(NewStatusBar())
+
+	other := &mockComponent{id: "other"}
+	tabs := NewTabs()
+	tabs.AddTab("Form", scroll)
+	tabs.AddTab("Other", other)
+
+	root := VBox()
+	root.AddFlex(tabs, 1)
+	root.AddFlex(notes, 1)
+
+	tree := NewFocusTree(root)
+	assertOrder(t, tree.Order(), "name", "email", "ScrollView")
+	if !name.focused {
+		t.Error("Expected the first field in the scroll view focused")
+	}
+
+	// Only the active tab's content is walked into
+	tabs.SetActive(1)
+	assertOrder(t, tree.Order(), "other", "ScrollView")
+}
+
+func TestFocusTreeTabIndex(t *testing.T) {
+	a, b := &mockComponent{id: "a"}, &mockComponent{id: "b"}
+	last := &tabIndexed{mockComponent: mockComponent{id: "last"}, index: 2}
+	first := &tabIndexed{mockComponent: mockComponent{id: "first"}, index: 1}
+	skipped := &tabIndexed{mockComponent: mockComponent{id: "skipped"}, index: -1}
+
+	root := HBox()
+	for _, component := range []Component{a, last, b, skipped, first} {
+		root.AddFlex(component, 1)
+	}
+
+	tree := NewFocusTree(root)
+	assertOrder(t, tree.Order(), "first", "last", "a", "b")
+
+	// An explicit override wins over the component's own index
+	tree.SetTabIndex(b, 3)
+	tree.SetTabIndex(last, 0)
+	assertOrder(t, tree.Order(), "first", "b", "a", "last")
+}
+
+func TestFocusTreeHandleKeyPassesThrough(t *testing.T) {
+	input := NewInput()
+	other := NewInput()
+
+	root := VBox()
+	root.AddFixed(input, 1)
+	root.AddFixed(other, 1)
+
+	tree := NewFocusTree(root)
+	tree.HandleKey("h")
+	tree.HandleKey("i")
+	if input.Value() != "hi" || other.Value() != "" {
+		t.Errorf("Expected keys to reach the focused input, got %q and %q", input.Value(), other.Value())
+	}
+
+	if tree.Focus(NewInput()) {
+		t.Error("Expected focusing a component outside the tree to fail")
+	}
+	if !tree.Focus(other) || tree.Focused() != Focusable(other) {
+		t.Error("Expected focus to move to the second input")
+	}
+	if input.IsFocused() {
+		t.Error("Expected the first input to be blurred")
+	}
+}
+
+func TestFocusTreeSpatialNavigation(t *testing.T) {
+	screen := NewScreenSimulation(40, 12).Screen
+	panes := newPanes()
+	drawPaneGrid(screen, panes)
+
+	tree := NewFocusTree(screen.DrawnRects()[0].Component)
+	tree.UpdateRects(screen.DrawnRects())
+
+	tree.HandleKey("alt+down")
+	tree.HandleKey("alt+right")
+	if !panes["d"].focused || panes["a"].focused {
+		t.Error("Expected alt+down then alt+right to focus d")
+	}
+}
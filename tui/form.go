@@ -0,0 +1,606 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// FormValue is implemented by components whose value a Form can read and
+// set, such as Input (a string)
+type FormValue interface {
+	FormValue() any
+	SetFormValue(value any) error
+}
+
+// Validator checks a field's value and returns an error describing what's
+// wrong with it, or nil
+type Validator func(value any) error
+
+// FormValidator checks several fields together
+// Returning a *FieldError shows the message under that field; any other
+// error is shown above the buttons.
+type FormValidator func(values map[string]any) error
+
+// FieldError is a validation error belonging to a field
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// Error returns the field name and message
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// Required rejects empty strings and unset values
+func Required() Validator {
+	return func(value any) error {
+		if value == nil || strings.TrimSpace(fmt.Sprint(value)) == "" {
+			return errors.New("required")
+		}
+		return nil
+	}
+}
+
+// MinLength rejects text shorter than n characters
+func MinLength(n int) Validator {
+	return func(value any) error {
+		if s, ok := value.(string); ok && len([]rune(s)) < n {
+			return fmt.Errorf("must be at least %d characters", n)
+		}
+		return nil
+	}
+}
+
+// MaxLength rejects text longer than n characters
+func MaxLength(n int) Validator {
+	return func(value any) error {
+		if s, ok := value.(string); ok && len([]rune(s)) > n {
+			return fmt.Errorf("must be at most %d characters", n)
+		}
+		return nil
+	}
+}
+
+// Pattern rejects non-empty text that doesn't match a regular expression
+// Combine it with Required to reject empty text too.
+func Pattern(pattern, message string) Validator {
+	re := regexp.MustCompile(pattern)
+	return func(value any) error {
+		if s, ok := value.(string); ok && s != "" && !re.MatchString(s) {
+			return errors.New(message)
+		}
+		return nil
+	}
+}
+
+// FormField is a labeled field of a Form
+type FormField struct {
+	Name       string // Key in Values and the struct tag used by Load and Store
+	Label      string
+	Widget     Component
+	Validators []Validator
+	Height     int // Rows for the widget; 0 uses its measured height
+	err        string
+}
+
+// Error returns the field's validation message, or ""
+func (f *FormField) Error() string {
+	return f.err
+}
+
+// FormResultMsg is the bubbletea message sent when a form is submitted or
+// cancelled
+// See Form.Update.
+type FormResultMsg struct {
+	ID        string
+	Values    map[string]any
+	Cancelled bool
+}
+
+// Form lays out labeled fields with their validation errors, followed by
+// submit and cancel buttons
+//
+// Fields are focused in the order they were added, then the buttons. Enter
+// in a single-line field moves to the next one and submits from the last.
+// Fields are validated when focus leaves them and again on submit; the form
+// only submits when every validator passes.
+type Form struct {
+	id         string
+	fields     []*FormField
+	validators []FormValidator
+	formErr    string
+	submit     string
+	cancel     string
+	focus      int // Index into fields, then the submit and cancel buttons
+	focused    bool
+	onSubmit   func(values map[string]any)
+	onCancel   func()
+	result     *FormResultMsg
+	keymap     *Keymap
+}
+
+// NewForm creates an empty form with Submit and Cancel buttons
+func NewForm() *Form {
+	return &Form{
+		submit: "Submit",
+		cancel: "Cancel",
+		keymap: DefaultFormKeymap(),
+	}
+}
+
+// DefaultFormKeymap returns the default key bindings of a Form
+// Keys not bound here go to the focused field.
+func DefaultFormKeymap() *Keymap {
+	return NewKeymap("form",
+		KeyBinding{Action: "next", Keys: []string{"tab"}, Help: "next field"},
+		KeyBinding{Action: "previous", Keys: []string{"shift+tab"}, Help: "previous field"},
+		KeyBinding{Action: "confirm", Keys: []string{"enter"}, Help: "next/submit"},
+		KeyBinding{Action: "submit", Keys: []string{"ctrl+s"}, Help: "submit"},
+		KeyBinding{Action: "cancel", Keys: []string{"esc"}, Help: "cancel"},
+	)
+}
+
+// Keymap returns the form's key bindings
+func (f *Form) Keymap() *Keymap {
+	return f.keymap
+}
+
+// SetKeymap sets the form's key bindings
+func (f *Form) SetKeymap(keymap *Keymap) {
+	f.keymap = keymap
+}
+
+// SetID sets the ID reported in FormResultMsg
+func (f *Form) SetID(id string) {
+	f.id = id
+}
+
+// SetButtons sets the button labels; an empty cancel label hides that button
+func (f *Form) SetButtons(submit, cancel string) {
+	f.submit = submit
+	f.cancel = cancel
+}
+
+// AddField adds a labeled field
+// The widget should implement FormValue for its value to be read.
+func (f *Form) AddField(name, label string, widget Component, validators ...Validator) *FormField {
+	field := &FormField{Name: name, Label: label, Widget: widget, Validators: validators}
+	f.fields = append(f.fields, field)
+	return field
+}
+
+// AddValidator adds a validator that checks fields against each other
+func (f *Form) AddValidator(validator FormValidator) {
+	f.validators = append(f.validators, validator)
+}
+
+// Field returns the field with a name, or nil
+func (f *Form) Field(name string) *FormField {
+	for _, field := range f.fields {
+		if field.Name == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// Fields returns the fields in order
+func (f *Form) Fields() []*FormField {
+	return append([]*FormField(nil), f.fields...)
+}
+
+// OnSubmit sets the function called with the values when the form submits
+func (f *Form) OnSubmit(handler func(values map[string]any)) {
+	f.onSubmit = handler
+}
+
+// OnCancel sets the function called when the form is cancelled
+func (f *Form) OnCancel(handler func()) {
+	f.onCancel = handler
+}
+
+// Value returns a field's value, or nil if it has none
+func (f *Form) Value(name string) any {
+	if field := f.Field(name); field != nil {
+		if v, ok := field.Widget.(FormValue); ok {
+			return v.FormValue()
+		}
+	}
+	return nil
+}
+
+// SetValue sets a field's value
+func (f *Form) SetValue(name string, value any) error {
+	field := f.Field(name)
+	if field == nil {
+		return fmt.Errorf("tui: form has no field %q", name)
+	}
+	v, ok := field.Widget.(FormValue)
+	if !ok {
+		return fmt.Errorf("tui: form field %q has no value", name)
+	}
+	return v.SetFormValue(value)
+}
+
+// Values returns the values of every field that has one, by name
+func (f *Form) Values() map[string]any {
+	values := map[string]any{}
+	for _, field := range f.fields {
+		if v, ok := field.Widget.(FormValue); ok {
+			values[field.Name] = v.FormValue()
+		}
+	}
+	return values
+}
+
+// validateField runs a field's validators and records the first error
+func (f *Form) validateField(field *FormField) bool {
+	field.err = ""
+	value := f.Value(field.Name)
+	for _, validator := range field.Validators {
+		if err := validator(value); err != nil {
+			field.err = err.Error()
+			return false
+		}
+	}
+	return true
+}
+
+// Validate runs every validator and shows their errors
+// Returns true if the form is valid.
+func (f *Form) Validate() bool {
+	valid := true
+	for _, field := range f.fields {
+		if !f.validateField(field) {
+			valid = false
+		}
+	}
+
+	f.formErr = ""
+	values := f.Values()
+	for _, validator := range f.validators {
+		err := validator(values)
+		if err == nil {
+			continue
+		}
+		valid = false
+		var fieldErr *FieldError
+		if errors.As(err, &fieldErr) {
+			if field := f.Field(fieldErr.Field); field != nil && field.err == "" {
+				field.err = fieldErr.Message
+				continue
+			}
+		}
+		if f.formErr == "" {
+			f.formErr = err.Error()
+		}
+	}
+	return valid
+}
+
+// Error returns the message of a failed form validator, or ""
+func (f *Form) Error() string {
+	return f.formErr
+}
+
+// Submit validates the form and, if it's valid, reports its values
+// Otherwise focus moves to the first field with an error. Returns whether
+// the form was submitted.
+func (f *Form) Submit() bool {
+	if !f.Validate() {
+		for i, field := range f.fields {
+			if field.err != "" {
+				f.setFocus(i)
+				break
+			}
+		}
+		return false
+	}
+	values := f.Values()
+	f.result = &FormResultMsg{ID: f.id, Values: values}
+	if f.onSubmit != nil {
+		f.onSubmit(values)
+	}
+	return true
+}
+
+// Cancel reports that the form was cancelled
+func (f *Form) Cancel() {
+	f.result = &FormResultMsg{ID: f.id, Cancelled: true}
+	if f.onCancel != nil {
+		f.onCancel()
+	}
+}
+
+// Reset clears validation errors and focuses the first field
+func (f *Form) Reset() {
+	for _, field := range f.fields {
+		field.err = ""
+	}
+	f.formErr = ""
+	f.result = nil
+	f.setFocus(0)
+}
+
+// Focused returns the focused field, or nil when a button has focus
+func (f *Form) Focused() *FormField {
+	if f.focus < len(f.fields) {
+		return f.fields[f.focus]
+	}
+	return nil
+}
+
+// IsOpen returns whether the focused field has a popup open, like a
+// Select's options
+func (f *Form) IsOpen() bool {
+	field := f.Focused()
+	return field != nil && isOpen(field.Widget)
+}
+
+// focusCount returns how many things can take focus: fields, then buttons
+func (f *Form) focusCount() int {
+	n := len(f.fields) + 1
+	if f.cancel != "" {
+		n++
+	}
+	return n
+}
+
+// setFocus moves focus, validating the field focus leaves
+func (f *Form) setFocus(index int) {
+	if index == f.focus {
+		f.syncFocus()
+		return
+	}
+	if field := f.Focused(); field != nil {
+		f.validateField(field)
+	}
+	f.focus = index
+	f.syncFocus()
+}
+
+// syncFocus gives the focused field's widget focus while the form has it
+func (f *Form) syncFocus() {
+	for i, field := range f.fields {
+		focusable, ok := field.Widget.(Focusable)
+		if !ok {
+			continue
+		}
+		if f.focused && i == f.focus {
+			focusable.Focus()
+		} else {
+			focusable.Blur()
+		}
+	}
+}
+
+// isOpen returns whether a widget has an open popup
+func isOpen(widget Component) bool {
+	opener, ok := widget.(interface{ IsOpen() bool })
+	return ok && opener.IsOpen()
+}
+
+// usesEnter returns whether a widget needs the enter key itself
+func usesEnter(widget Component) bool {
+	_, multiline := widget.(*TextArea)
+	return multiline
+}
+
+// HandleKey processes keyboard input
+// Returns true if the key was handled
+func (f *Form) HandleKey(key string) bool {
+	f.result = nil
+	field := f.Focused()
+	action := f.keymap.Action(key)
+
+	// Widgets with their own key handling get first refusal, except for
+	// the keys that leave the field. A widget with an open popup, like a
+	// Select, gets those too.
+	leaving := action == "next" || action == "previous" || action == "cancel"
+	if field != nil && (!leaving || isOpen(field.Widget)) {
+		if handler, ok := field.Widget.(interface{ HandleKey(string) bool }); ok && handler.HandleKey(key) {
+			return true
+		}
+		if action == "confirm" && usesEnter(field.Widget) {
+			action = ""
+		}
+	}
+
+	count := f.focusCount()
+	switch action {
+	case "next":
+		f.setFocus((f.focus + 1) % count)
+	case "previous":
+		f.setFocus((f.focus - 1 + count) % count)
+	case "submit":
+		f.Submit()
+	case "cancel":
+		f.Cancel()
+	case "confirm":
+		switch {
+		case f.focus == len(f.fields)+1:
+			f.Cancel()
+		case f.focus >= len(f.fields)-1:
+			f.Submit()
+		default:
+			f.setFocus(f.focus + 1)
+		}
+	default:
+		if field == nil {
+			return false
+		}
+		field.Widget.HandleInput(key)
+	}
+	return true
+}
+
+// HandleInput processes keyboard input
+func (f *Form) HandleInput(key string) {
+	f.HandleKey(key)
+}
+
+// Update handles a bubbletea message and returns a command that sends a
+// FormResultMsg when the form is submitted or cancelled
+func (f *Form) Update(msg tea.Msg) tea.Cmd {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+	f.HandleKey(keyMsg.String())
+	if f.result == nil {
+		return nil
+	}
+	result := *f.result
+	return func() tea.Msg {
+		return result
+	}
+}
+
+// Focus gives keyboard focus to the form's focused field
+func (f *Form) Focus() {
+	f.focused = true
+	f.syncFocus()
+}
+
+// Blur removes keyboard focus from the form
+func (f *Form) Blur() {
+	f.focused = false
+	f.syncFocus()
+}
+
+// IsFocused returns whether this component currently has focus
+func (f *Form) IsFocused() bool {
+	return f.focused
+}
+
+// formMinFieldWidth is the narrowest a field's widget is laid out
+const formMinFieldWidth = 20
+
+// labelWidth returns the width of the label column
+func (f *Form) labelWidth() int {
+	width := 0
+	for _, field := range f.fields {
+		width = max(width, StringWidth(field.Label))
+	}
+	return width
+}
+
+// fieldHeight returns the rows a field's widget takes
+func (f *Form) fieldHeight(field *FormField, width int) int {
+	if field.Height > 0 {
+		return field.Height
+	}
+	if measurable, ok := field.Widget.(Measurer); ok {
+		_, h := measurable.Measure(width, 10)
+		return max(1, h)
+	}
+	return 1
+}
+
+// buttons returns the labels of the buttons shown
+func (f *Form) buttons() []string {
+	if f.cancel == "" {
+		return []string{f.submit}
+	}
+	return []string{f.submit, f.cancel}
+}
+
+// buttonsWidth returns the width of the button row
+func (f *Form) buttonsWidth() int {
+	width := 0
+	for i, label := range f.buttons() {
+		if i > 0 {
+			width += 2
+		}
+		width += StringWidth(label) + 4
+	}
+	return width
+}
+
+// Measure returns the size of the labels, fields, errors and buttons
+func (f *Form) Measure(maxWidth, maxHeight int) (width, height int) {
+	labelWidth := f.labelWidth()
+	fieldWidth := formMinFieldWidth
+	for _, field := range f.fields {
+		if measurable, ok := field.Widget.(Measurer); ok {
+			w, _ := measurable.Measure(maxWidth-labelWidth-2, maxHeight)
+			fieldWidth = max(fieldWidth, w)
+		}
+		height += f.fieldHeight(field, fieldWidth)
+		if field.err != "" {
+			height++
+		}
+	}
+	if f.formErr != "" {
+		height++
+	}
+	height += 2 // Gap and buttons
+	width = max(labelWidth+2+fieldWidth, f.buttonsWidth())
+	return min(width, maxWidth), min(height, maxHeight)
+}
+
+// Draw renders the fields in a label column and a field column, each
+// field's error under it, and the buttons at the bottom
+func (f *Form) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	if availableWidth <= 0 || availableHeight <= 0 {
+		return
+	}
+	labelWidth := f.labelWidth()
+	fieldX := x + labelWidth + 2
+	fieldWidth := max(1, x+availableWidth-fieldX)
+
+	labelStyle := lipgloss.NewStyle().Foreground(theme.Palette.Text)
+	focusedLabelStyle := labelStyle.Foreground(theme.Palette.Primary).Bold(true)
+	errorStyle := lipgloss.NewStyle().Foreground(theme.Palette.Love)
+
+	bottom := y + availableHeight
+	buttonsRow := bottom - 1
+	row := y
+	for i, field := range f.fields {
+		h := min(f.fieldHeight(field, fieldWidth), buttonsRow-row)
+		if h <= 0 {
+			break
+		}
+		style := labelStyle
+		if f.focused && i == f.focus {
+			style = focusedLabelStyle
+		}
+		screen.DrawString(x, row, Truncate(field.Label, labelWidth), style)
+		screen.DrawComponent(field.Widget, fieldX, row, fieldWidth, h, theme)
+		row += h
+
+		if field.err != "" && row < buttonsRow {
+			screen.DrawString(fieldX, row, Truncate("✗ "+field.err, fieldWidth), errorStyle)
+			row++
+		}
+	}
+
+	if f.formErr != "" && row < buttonsRow {
+		screen.DrawString(x, row, Truncate("✗ "+f.formErr, availableWidth), errorStyle)
+	}
+	f.drawButtons(screen, x, buttonsRow, availableWidth, theme)
+}
+
+// drawButtons draws the button row centered, highlighting the focused one
+func (f *Form) drawButtons(screen *Screen, x, y, width int, theme *Theme) {
+	colors := theme.Components.Interactive
+	buttonX := x + max(0, (width-f.buttonsWidth())/2)
+
+	for i, label := range f.buttons() {
+		state := colors.Normal
+		focused := f.focused && f.focus == len(f.fields)+i
+		if focused {
+			state = colors.Selected
+		}
+		style := lipgloss.NewStyle().Foreground(state.Text).Background(state.Background).Bold(focused)
+		text := "[ " + label + " ]"
+		screen.DrawString(buttonX, y, text, style)
+		buttonX += StringWidth(text) + 2
+	}
+}
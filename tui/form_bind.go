@@ -0,0 +1,150 @@
+package tui
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Load sets field values from a struct
+//
+// Struct fields are matched to form fields by their `form:"name"` tag, or by
+// their name when untagged; a tag of "-" skips the field. Values are
+// converted to what the widget holds, so an int can fill an Input. Struct
+// fields without a matching form field are ignored.
+func (f *Form) Load(v any) error {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("tui: Load needs a struct, got %T", v)
+	}
+
+	for _, bound := range boundFields(rv) {
+		name, value := bound.name, bound.value
+		field := f.Field(name)
+		if field == nil {
+			continue
+		}
+		widget, ok := field.Widget.(FormValue)
+		if !ok {
+			continue
+		}
+		converted, err := convertValue(value, reflect.TypeOf(widget.FormValue()))
+		if err != nil {
+			return fmt.Errorf("tui: loading form field %q: %w", name, err)
+		}
+		if err := widget.SetFormValue(converted.Interface()); err != nil {
+			return fmt.Errorf("tui: loading form field %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Store copies field values into a struct, matching fields as Load does
+// v must be a pointer. Text is parsed into numeric and boolean fields; a
+// value that doesn't parse is reported as that field's error.
+func (f *Form) Store(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("tui: Store needs a pointer to a struct, got %T", v)
+	}
+
+	for _, bound := range boundFields(rv.Elem()) {
+		name, target := bound.name, bound.value
+		value := f.Value(name)
+		if value == nil || !target.CanSet() {
+			continue
+		}
+		converted, err := convertValue(reflect.ValueOf(value), target.Type())
+		if err != nil {
+			if field := f.Field(name); field != nil {
+				field.err = err.Error()
+			}
+			return &FieldError{Field: name, Message: err.Error()}
+		}
+		target.Set(converted)
+	}
+	return nil
+}
+
+// boundField is a struct field and the form field name it binds to
+type boundField struct {
+	name  string
+	value reflect.Value
+}
+
+// boundFields returns a struct's exported fields in order
+func boundFields(rv reflect.Value) []boundField {
+	var fields []boundField
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name := sf.Tag.Get("form")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		fields = append(fields, boundField{name, rv.Field(i)})
+	}
+	return fields
+}
+
+// convertValue converts between a widget's value and a struct field's,
+// formatting and parsing text where the kinds differ
+func convertValue(value reflect.Value, to reflect.Type) (reflect.Value, error) {
+	if value.Type().AssignableTo(to) {
+		return value, nil
+	}
+
+	from := value.Kind()
+	kind := to.Kind()
+	switch {
+	case from == kind:
+		if !value.CanConvert(to) {
+			// Same kind isn't enough for structs, slices and the like
+			return reflect.Value{}, fmt.Errorf("can't convert %s to %s", value.Type(), to)
+		}
+		return value.Convert(to), nil
+	case kind == reflect.String:
+		return reflect.ValueOf(fmt.Sprint(value.Interface())).Convert(to), nil
+	case from != reflect.String:
+		return reflect.Value{}, fmt.Errorf("can't convert %s to %s", value.Type(), to)
+	}
+
+	text := strings.TrimSpace(value.String())
+	result := reflect.New(to).Elem()
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, to.Bits())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("must be a whole number")
+		}
+		result.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(text, 10, to.Bits())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("must be a positive whole number")
+		}
+		result.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(text, to.Bits())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("must be a number")
+		}
+		result.SetFloat(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("must be true or false")
+		}
+		result.SetBool(b)
+	default:
+		return reflect.Value{}, fmt.Errorf("can't convert %s to %s", value.Type(), to)
+	}
+	return result, nil
+}
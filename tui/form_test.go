@@ -0,0 +1,198 @@
+package tui
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func typeInto(form *Form, text string) {
+	for _, r := range text {
+		form.HandleKey(string(r))
+	}
+}
+
+func newTestForm() *Form {
+	form := NewForm()
+	form.AddField("name", "Name", NewInput(), Required())
+	form.AddField("email", "Email", NewInput(), Required(), Pattern(`^[^@]+@[^@]+$`, "must be an email address"))
+	form.AddField("age", "Age", NewInput())
+	form.Focus()
+	return form
+}
+
+func TestFormFocusFollowsFieldOrder(t *testing.T) {
+	form := newTestForm()
+	name := form.Field("name").Widget.(*Input)
+	email := form.Field("email").Widget.(*Input)
+
+	if !name.IsFocused() || email.IsFocused() {
+		t.Fatal("Expected the first field focused")
+	}
+	form.HandleKey("tab")
+	if name.IsFocused() || !email.IsFocused() {
+		t.Error("Expected tab to focus the second field")
+	}
+	form.HandleKey("tab")
+	form.HandleKey("tab") // Submit button
+	form.HandleKey("tab") // Cancel button
+	if form.Focused() != nil {
+		t.Error("Expected a button focused after the fields")
+	}
+	form.HandleKey("tab") // Wraps
+	if form.Focused() != form.Field("name") {
+		t.Error("Expected focus to wrap to the first field")
+	}
+	form.HandleKey("shift+tab")
+	form.HandleKey("shift+tab")
+	form.HandleKey("shift+tab")
+	if form.Focused() != form.Field("age") {
+		t.Error("Expected shift+tab to move back to the last field")
+	}
+}
+
+func TestFormValidatesOnLeaveAndSubmit(t *testing.T) {
+	var submitted map[string]any
+	form := newTestForm()
+	form.OnSubmit(func(values map[string]any) { submitted = values })
+
+	form.HandleKey("tab") // Leaving the empty name field
+	if form.Field("name").Error() != "required" {
+		t.Errorf("Expected name to be required, got %q", form.Field("name").Error())
+	}
+
+	typeInto(form, "nope")
+	form.HandleKey("ctrl+s")
+	if submitted != nil {
+		t.Fatal("Expected an invalid form not to submit")
+	}
+	if form.Field("email").Error() != "must be an email address" {
+		t.Errorf("Expected an email error, got %q", form.Field("email").Error())
+	}
+	if form.Focused() != form.Field("name") {
+		t.Error("Expected focus on the first invalid field")
+	}
+
+	typeInto(form, "Ada")
+	form.HandleKey("enter") // Next field
+	typeInto(form, "@example.com")
+	form.HandleKey("enter")
+	form.HandleKey("enter") // Submits from the last field
+	if submitted["name"] != "Ada" || submitted["email"] != "nope@example.com" {
+		t.Errorf("Expected the submitted values, got %v", submitted)
+	}
+	if form.Field("email").Error() != "" {
+		t.Error("Expected errors cleared once valid")
+	}
+}
+
+func TestFormCrossFieldValidation(t *testing.T) {
+	form := NewForm()
+	form.AddField("password", "Password", NewInput())
+	form.AddField("confirm", "Confirm", NewInput())
+	form.AddValidator(func(values map[string]any) error {
+		if values["password"] != values["confirm"] {
+			return &FieldError{Field: "confirm", Message: "passwords don't match"}
+		}
+		return nil
+	})
+	form.AddValidator(func(values map[string]any) error {
+		return errors.New("server unavailable")
+	})
+
+	form.SetValue("password", "secret")
+	form.SetValue("confirm", "secrte")
+	if form.Validate() {
+		t.Fatal("Expected the form to be invalid")
+	}
+	if form.Field("confirm").Error() != "passwords don't match" {
+		t.Errorf("Expected the error under confirm, got %q", form.Field("confirm").Error())
+	}
+	if form.Error() != "server unavailable" {
+		t.Errorf("Expected a form-level error, got %q", form.Error())
+	}
+}
+
+func TestFormUpdateSendsResult(t *testing.T) {
+	form := newTestForm()
+	form.SetID("signup")
+
+	cmd := form.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil {
+		t.Fatal("Expected a command when cancelled")
+	}
+	if msg := cmd().(FormResultMsg); msg.ID != "signup" || !msg.Cancelled {
+		t.Errorf("Expected a cancelled result, got %+v", msg)
+	}
+	if form.Update(tea.KeyMsg{Type: tea.KeyTab}) != nil {
+		t.Error("Expected no command while editing")
+	}
+}
+
+type testProfile struct {
+	Name    string `form:"name"`
+	Email   string `form:"email"`
+	Age     int    `form:"age"`
+	Ignored string `form:"-"`
+}
+
+func TestFormStructBinding(t *testing.T) {
+	form := newTestForm()
+	if err := form.Load(testProfile{Name: "Ada", Email: "ada@example.com", Age: 36}); err != nil {
+		t.Fatal(err)
+	}
+	if form.Value("age") != "36" {
+		t.Errorf("Expected the age formatted into the input, got %v", form.Value("age"))
+	}
+
+	form.SetValue("age", "37")
+	var profile testProfile
+	if err := form.Store(&profile); err != nil {
+		t.Fatal(err)
+	}
+	if profile.Name != "Ada" || profile.Age != 37 {
+		t.Errorf("Expected the values stored, got %+v", profile)
+	}
+
+	form.SetValue("age", "old")
+	err := form.Store(&profile)
+	var fieldErr *FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Field != "age" {
+		t.Fatalf("Expected an age field error, got %v", err)
+	}
+	if form.Field("age").Error() != "must be a whole number" {
+		t.Errorf("Expected the parse error shown on the field, got %q", form.Field("age").Error())
+	}
+	if err := form.Store(profile); err == nil {
+		t.Error("Expected Store to need a pointer")
+	}
+}
+
+func TestFormBindingRejectsMismatchedTypes(t *testing.T) {
+	tests := []struct{ from, to any }{
+		{[]int{1}, []string{}},
+		{struct{ A int }{}, struct{ B string }{}},
+	}
+	for _, tt := range tests {
+		if _, err := convertValue(reflect.ValueOf(tt.from), reflect.TypeOf(tt.to)); err == nil {
+			t.Errorf("Expected an error converting %T to %T", tt.from, tt.to)
+		}
+	}
+}
+
+func TestFormDraw(t *testing.T) {
+	form := newTestForm()
+	form.HandleKey("tab")
+
+	sim := NewScreenSimulation(40, 8)
+	form.Draw(sim.Screen, 0, 0, 40, 8, NewTestTheme())
+
+	AssertTextExists(t, sim, "Name")
+	AssertTextExists(t, sim, "✗ required")
+	AssertTextExists(t, sim, "[ Submit ]")
+	AssertTextExists(t, sim, "[ Cancel ]")
+	// Fields line up after the widest label
+	AssertCellRune(t, sim, 7, 1, '✗')
+}
@@ -0,0 +1,147 @@
+package tui
+
+import (
+	"sort"
+	"unicode"
+)
+
+// Fuzzy scoring weights
+const (
+	fuzzyMatchScore       = 16 // Every matched character
+	fuzzyGapPenalty       = 1  // Every character skipped between matches
+	fuzzyLeadingPenalty   = 1  // Every character skipped before the first match, up to fuzzyMaxLeading
+	fuzzyMaxLeading       = 6
+	fuzzyStartBonus       = 12 // Match at the very start of the text
+	fuzzyBoundaryBonus    = 10 // Match at the start of a word
+	fuzzyCamelBonus       = 8  // Match at a lower-to-upper case change
+	fuzzyConsecutiveBonus = 6  // Match right after the previous one
+	fuzzyCaseBonus        = 1  // Match with the same case as the pattern
+)
+
+// FuzzyMatch is how a pattern matched a text
+type FuzzyMatch struct {
+	Score     int   // Higher is better
+	Positions []int // Rune indexes of the matched characters in the text
+}
+
+// FuzzyResult is a matched item from FuzzyFilter
+type FuzzyResult struct {
+	Index int // Position of the item in the input
+	FuzzyMatch
+}
+
+// FuzzyScore matches a pattern against a text, ignoring case
+//
+// Every character of the pattern has to appear in the text in order, but
+// not necessarily next to each other. Among the possible alignments the best
+// scoring one is chosen: matches at the start of words, at camelCase humps
+// and right after each other score higher, while gaps cost a little. An
+// empty pattern matches everything with a score of 0.
+func FuzzyScore(pattern, text string) (FuzzyMatch, bool) {
+	p := []rune(pattern)
+	t := []rune(text)
+	if len(p) == 0 {
+		return FuzzyMatch{}, true
+	}
+	if len(p) > len(t) {
+		return FuzzyMatch{}, false
+	}
+
+	const none = -1 << 30
+	n := len(t)
+	// score[i][j] is the best score of p[:i+1] with p[i] matched at t[j];
+	// from[i][j] is where p[i-1] was matched in that alignment
+	score := make([][]int, len(p))
+	from := make([][]int, len(p))
+	for i := range p {
+		score[i] = make([]int, n)
+		from[i] = make([]int, n)
+		for j := range score[i] {
+			score[i][j] = none
+		}
+	}
+
+	for i, pr := range p {
+		best, bestAt := none, -1 // Best earlier alignment of p[:i], less gap penalties
+		for j := i; j < n; j++ {
+			if i > 0 {
+				if best != none {
+					best -= fuzzyGapPenalty
+				}
+				if prev := score[i-1][j-1]; prev > best {
+					best, bestAt = prev, j-1
+				}
+			}
+			if unicode.ToLower(t[j]) != unicode.ToLower(pr) {
+				continue
+			}
+
+			charScore := fuzzyMatchScore + fuzzyBonus(t, j)
+			if t[j] == pr {
+				charScore += fuzzyCaseBonus
+			}
+			if i == 0 {
+				score[i][j] = charScore - fuzzyLeadingPenalty*min(j, fuzzyMaxLeading)
+				continue
+			}
+
+			if best != none {
+				score[i][j], from[i][j] = best+charScore, bestAt
+			}
+			if prev := score[i-1][j-1]; prev != none && prev+charScore+fuzzyConsecutiveBonus > score[i][j] {
+				score[i][j], from[i][j] = prev+charScore+fuzzyConsecutiveBonus, j-1
+			}
+		}
+	}
+
+	last := len(p) - 1
+	end := -1
+	for j := last; j < n; j++ {
+		if score[last][j] != none && (end < 0 || score[last][j] > score[last][end]) {
+			end = j
+		}
+	}
+	if end < 0 {
+		return FuzzyMatch{}, false
+	}
+
+	positions := make([]int, len(p))
+	for i, j := last, end; i >= 0; i-- {
+		positions[i] = j
+		j = from[i][j]
+	}
+	return FuzzyMatch{Score: score[last][end], Positions: positions}, true
+}
+
+// fuzzyBonus returns the bonus for matching the character at index j
+func fuzzyBonus(t []rune, j int) int {
+	if j == 0 {
+		return fuzzyStartBonus
+	}
+	prev, cur := t[j-1], t[j]
+	switch {
+	case !unicode.IsLetter(prev) && !unicode.IsDigit(prev) && (unicode.IsLetter(cur) || unicode.IsDigit(cur)):
+		return fuzzyBoundaryBonus
+	case unicode.IsLower(prev) && unicode.IsUpper(cur):
+		return fuzzyCamelBonus
+	case !unicode.IsDigit(prev) && unicode.IsDigit(cur):
+		return fuzzyCamelBonus
+	}
+	return 0
+}
+
+// FuzzyFilter matches a pattern against items and returns the matches, best
+// first
+// Items with equal scores keep their original order.
+func FuzzyFilter(pattern string, items []string) []FuzzyResult {
+	var results []FuzzyResult
+	for i, item := range items {
+		if match, ok := FuzzyScore(pattern, item); ok {
+			results = append(results, FuzzyResult{Index: i, FuzzyMatch: match})
+		}
+	}
+	sort.SliceStable(results, func(a, b int) bool {
+		return results[a].Score > results[b].Score
+	})
+	return results
+}
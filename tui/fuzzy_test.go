@@ -0,0 +1,51 @@
+package tui
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFuzzyScoreMatchesSubsequence(t *testing.T) {
+	match, ok := FuzzyScore("opf", "Open File")
+	if !ok {
+		t.Fatal("Expected opf to match Open File")
+	}
+	if want := []int{0, 1, 5}; !reflect.DeepEqual(match.Positions, want) {
+		t.Errorf("Expected positions %v, got %v", want, match.Positions)
+	}
+
+	if _, ok := FuzzyScore("fo", "Open File"); ok {
+		t.Error("Expected out-of-order characters not to match")
+	}
+	if _, ok := FuzzyScore("", "anything"); !ok {
+		t.Error("Expected an empty pattern to match")
+	}
+}
+
+func TestFuzzyScorePrefersWordBoundaries(t *testing.T) {
+	boundary, _ := FuzzyScore("fb", "FooBar")
+	scattered, _ := FuzzyScore("fb", "fabric")
+	if boundary.Score <= scattered.Score {
+		t.Errorf("Expected the camelCase match to score higher, got %d and %d", boundary.Score, scattered.Score)
+	}
+
+	// The alignment at word starts wins over the first occurrence
+	match, _ := FuzzyScore("sf", "close save-file")
+	if want := []int{6, 11}; !reflect.DeepEqual(match.Positions, want) {
+		t.Errorf("Expected positions %v, got %v", want, match.Positions)
+	}
+}
+
+func TestFuzzyFilterSortsByScore(t *testing.T) {
+	items := []string{"reload theme", "Toggle Theme", "Go to line", "theme picker"}
+	results := FuzzyFilter("theme", items)
+
+	var got []int
+	for _, result := range results {
+		got = append(got, result.Index)
+	}
+	// The prefix match first, then the others in their original order
+	if want := []int{3, 0, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected order %v, got %v", want, got)
+	}
+}
@@ -1,9 +1,16 @@
 package tui
 
 import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
+// CursorBlinkInterval is how long a blinking cursor stays on or off
+const CursorBlinkInterval = 530 * time.Millisecond
+
 // Input represents a single-line text input field
 type Input struct {
 	value       string
@@ -12,6 +19,10 @@ type Input struct {
 	width       int
 	placeholder string
 	focused     bool
+	blink       bool      // Whether the cursor blinks
+	blinkStart  time.Time // When the cursor last became solid
+	blinkTimer  *Timer
+	keymap      *Keymap
 }
 
 // NewInput creates a new input field
@@ -23,7 +34,75 @@ func NewInput() *Input {
 		width:       20,
 		placeholder: "",
 		focused:     false,
+		blinkTimer:  NewTimer(nil),
+		keymap:      DefaultInputKeymap(),
+	}
+}
+
+// DefaultInputKeymap returns the default key bindings of an Input
+func DefaultInputKeymap() *Keymap {
+	return NewKeymap("input", append(lineEditBindings(),
+		KeyBinding{Action: "delete-to-end", Keys: []string{"ctrl+k"}, Help: "delete to end"},
+		KeyBinding{Action: "delete-to-start", Keys: []string{"ctrl+u"}, Help: "delete to start"},
+	)...)
+}
+
+// Keymap returns the input's key bindings
+func (i *Input) Keymap() *Keymap {
+	return i.keymap
+}
+
+// SetKeymap sets the input's key bindings
+func (i *Input) SetKeymap(keymap *Keymap) {
+	i.keymap = keymap
+}
+
+// SetCursorBlink sets whether the cursor blinks while the input is focused
+// The blink is driven by TimerCmd and HandleTimer.
+func (i *Input) SetCursorBlink(blink bool) {
+	i.blink = blink
+	i.resetBlink()
+}
+
+// SetClock sets the clock used for blinking the cursor
+func (i *Input) SetClock(clock Clock) {
+	i.blinkTimer.SetClock(clock)
+	i.resetBlink()
+}
+
+// resetBlink shows the cursor solid and restarts the blink cycle
+func (i *Input) resetBlink() {
+	i.blinkStart = i.blinkTimer.Clock().Now()
+}
+
+// cursorVisible returns whether a blinking cursor is in its on phase
+func (i *Input) cursorVisible() bool {
+	if !i.blink {
+		return true
+	}
+	elapsed := i.blinkTimer.Clock().Now().Sub(i.blinkStart)
+	return (elapsed/CursorBlinkInterval)%2 == 0
+}
+
+// TimerCmd returns a command that fires when the blinking cursor next
+// toggles, or nil if it isn't blinking
+func (i *Input) TimerCmd() tea.Cmd {
+	if !i.blink || !i.focused {
+		i.blinkTimer.Stop()
+		return nil
 	}
+	elapsed := i.blinkTimer.Clock().Now().Sub(i.blinkStart)
+	next := i.blinkStart.Add((elapsed/CursorBlinkInterval + 1) * CursorBlinkInterval)
+	return i.blinkTimer.Schedule(next)
+}
+
+// HandleTimer schedules the next cursor toggle when the blink timer fires
+// Redrawing after the message shows the new cursor state.
+func (i *Input) HandleTimer(msg TimerMsg) tea.Cmd {
+	if !i.blinkTimer.Fired(msg) {
+		return nil
+	}
+	return i.TimerCmd()
 }
 
 // SetWidth sets the display width of the input
@@ -56,9 +135,25 @@ func (i *Input) Value() string {
 	return i.value
 }
 
+// FormValue returns the value as a string for Form
+func (i *Input) FormValue() any {
+	return i.value
+}
+
+// SetFormValue sets the value from a string for Form
+func (i *Input) SetFormValue(value any) error {
+	s, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("tui: input value must be a string, got %T", value)
+	}
+	i.SetValue(s)
+	return nil
+}
+
 // Focus sets the focus state
 func (i *Input) Focus() {
 	i.focused = true
+	i.resetBlink()
 }
 
 // Blur removes focus
@@ -73,27 +168,30 @@ func (i *Input) IsFocused() bool {
 
 // HandleInput processes keyboard input
 func (i *Input) HandleInput(key string) {
-	switch key {
-	case "left", "ctrl+b":
+	// Keep the cursor solid while typing
+	i.resetBlink()
+
+	switch i.keymap.Action(key) {
+	case "cursor-left":
 		i.moveCursorLeft()
 		i.adjustOffset()
-	case "right", "ctrl+f":
+	case "cursor-right":
 		i.moveCursorRight()
 		i.adjustOffset()
-	case "home", "ctrl+a":
+	case "line-start":
 		i.cursor = 0
 		i.adjustOffset()
-	case "end", "ctrl+e":
+	case "line-end":
 		i.cursor = StringWidth(i.value)
 		i.adjustOffset()
-	case "backspace", "ctrl+h":
+	case "delete-back":
 		i.deleteBeforeCursorInput()
 		i.adjustOffset()
-	case "delete", "ctrl+d":
+	case "delete-forward":
 		i.deleteAtCursorInput()
-	case "ctrl+k": // Kill to end of line
+	case "delete-to-end": // Kill to end of line
 		i.killToEndOfLine()
-	case "ctrl+u": // Kill to beginning of line
+	case "delete-to-start": // Kill to beginning of line
 		i.killToBeginningOfLine()
 		i.adjustOffset()
 	default:
@@ -164,7 +262,7 @@ func (i *Input) Draw(screen *Screen, x, y, availableWidth, availableHeight int,
 	}
 
 	// Draw cursor if focused
-	if i.focused && i.cursor >= i.offset && i.cursor <= i.offset+inputWidth {
+	if i.focused && i.cursorVisible() && i.cursor >= i.offset && i.cursor <= i.offset+inputWidth {
 		cursorX := x + i.cursor - i.offset
 		cursorStyle := lipgloss.NewStyle().
 			Foreground(theme.Palette.Background).
@@ -182,3 +280,10 @@ func (i *Input) Draw(screen *Screen, x, y, availableWidth, availableHeight int,
 }
 
 
+
+// Measure returns the preferred size of the input: wide enough for its value
+// (plus the cursor) or its placeholder, and one row tall
+func (i *Input) Measure(maxWidth, maxHeight int) (width, height int) {
+	width = max(StringWidth(i.value)+1, StringWidth(i.placeholder))
+	return min(width, maxWidth), 1
+}
@@ -0,0 +1,306 @@
+package tui
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// SequenceEvent is an outcome of feeding keys to a KeySequencer: either the
+// action of a completed sequence, or a key that isn't part of any sequence
+// and should be handled as usual
+type SequenceEvent struct {
+	Action string
+	Key    string
+}
+
+// sequenceBinding is a key sequence bound directly on a KeySequencer
+type sequenceBinding struct {
+	sequence string
+	action   string
+}
+
+// KeySequencer recognizes multi-key sequences such as "g g", "ctrl+x ctrl+s"
+// or "<leader> f"
+//
+// Sequences are written as space-separated keys; "<leader>" stands for the
+// leader key and "space" for the space bar. While the keys typed so far are
+// the start of a sequence they are held as pending. If they also complete a
+// shorter sequence ("g" as well as "g g"), the longest one completed fires
+// when the timeout passes or a key that doesn't continue the sequence
+// arrives, and the keys typed after it are matched again.
+type KeySequencer struct {
+	bindings []sequenceBinding
+	keymaps  []*Keymap
+	leader   string
+	timeout  time.Duration
+	pending  []string
+	exact    string // Action of the longest sequence the pending keys start with
+	matched  int    // Number of pending keys that sequence takes
+	lastKey  time.Time
+	timer    *Timer
+	onAction func(action string)
+	onKey    func(key string)
+}
+
+// NewKeySequencer creates a key sequencer with "\" as the leader key and a
+// one second timeout
+func NewKeySequencer() *KeySequencer {
+	return &KeySequencer{
+		leader:  "\\",
+		timeout: time.Second,
+		timer:   NewTimer(nil),
+	}
+}
+
+// Bind binds a key sequence to an action
+func (s *KeySequencer) Bind(sequence, action string) {
+	s.bindings = append(s.bindings, sequenceBinding{sequence, action})
+}
+
+// AddKeymap makes the keymap's bindings available as sequences
+// Keys containing spaces, such as "g g", are sequences. The keymap is read
+// on every key, so later changes to it take effect.
+func (s *KeySequencer) AddKeymap(keymap *Keymap) {
+	s.keymaps = append(s.keymaps, keymap)
+}
+
+// SetLeader sets the key that "<leader>" stands for
+func (s *KeySequencer) SetLeader(key string) {
+	s.leader = key
+}
+
+// SetTimeout sets how long to wait for the next key of a sequence
+func (s *KeySequencer) SetTimeout(timeout time.Duration) {
+	s.timeout = timeout
+}
+
+// SetClock sets the clock used for timeouts
+func (s *KeySequencer) SetClock(clock Clock) {
+	s.timer.SetClock(clock)
+}
+
+// OnAction sets the function HandleKey and HandleTimer call for completed
+// sequences
+func (s *KeySequencer) OnAction(handler func(action string)) {
+	s.onAction = handler
+}
+
+// OnKey sets the function HandleKey and HandleTimer call for keys that
+// aren't part of a sequence
+func (s *KeySequencer) OnKey(handler func(key string)) {
+	s.onKey = handler
+}
+
+// Pending returns the keys of the sequence typed so far
+func (s *KeySequencer) Pending() []string {
+	return append([]string(nil), s.pending...)
+}
+
+// PendingText returns the pending keys for display, such as "ctrl+x", or ""
+// when there are none
+// It suits a dynamic StatusBar segment.
+func (s *KeySequencer) PendingText() string {
+	keys := make([]string, len(s.pending))
+	for i, key := range s.pending {
+		if key == " " {
+			key = "space"
+		}
+		keys[i] = key
+	}
+	return strings.Join(keys, " ")
+}
+
+// Feed processes a key and returns what happened, in order
+// Returns nothing while the key is part of an unfinished sequence.
+func (s *KeySequencer) Feed(key string) []SequenceEvent {
+	now := s.timer.Clock().Now()
+	events := s.expire(now)
+	return append(events, s.feed(key, now)...)
+}
+
+// feed matches a key against the bindings after the pending keys
+func (s *KeySequencer) feed(key string, now time.Time) []SequenceEvent {
+	keys := append(append([]string(nil), s.pending...), key)
+	exact, prefix := s.match(keys)
+	switch {
+	case prefix:
+		s.pending, s.lastKey = keys, now
+		if exact != "" {
+			s.exact, s.matched = exact, len(keys)
+		}
+		return nil
+	case exact != "":
+		s.reset()
+		return []SequenceEvent{{Action: exact}}
+	case len(s.pending) == 0:
+		return []SequenceEvent{{Key: key}}
+	}
+
+	// The key broke the pending sequence: settle the start of it, then
+	// match what's left again
+	events, rest := s.settle()
+	for _, key := range append(rest, key) {
+		events = append(events, s.feed(key, now)...)
+	}
+	return events
+}
+
+// Expire settles a pending sequence whose timeout has passed
+func (s *KeySequencer) Expire() []SequenceEvent {
+	return s.expire(s.timer.Clock().Now())
+}
+
+// Flush settles a pending sequence immediately
+func (s *KeySequencer) Flush() []SequenceEvent {
+	return s.resolve()
+}
+
+// expire settles the pending sequence if it timed out by now
+func (s *KeySequencer) expire(now time.Time) []SequenceEvent {
+	if len(s.pending) == 0 || now.Before(s.lastKey.Add(s.timeout)) {
+		return nil
+	}
+	return s.resolve()
+}
+
+// resolve settles all the pending keys
+func (s *KeySequencer) resolve() []SequenceEvent {
+	var events []SequenceEvent
+	for len(s.pending) > 0 {
+		now := s.lastKey
+		settled, rest := s.settle()
+		events = append(events, settled...)
+		for _, key := range rest {
+			events = append(events, s.feed(key, now)...)
+		}
+	}
+	return events
+}
+
+// settle fires the longest sequence the pending keys start with, or passes
+// the first key on as a plain key when there is none, and clears the
+// pending keys
+// Returns the keys after the ones settled, to be matched again.
+func (s *KeySequencer) settle() (events []SequenceEvent, rest []string) {
+	if s.exact != "" {
+		events = []SequenceEvent{{Action: s.exact}}
+		rest = s.pending[s.matched:]
+	} else {
+		events = []SequenceEvent{{Key: s.pending[0]}}
+		rest = s.pending[1:]
+	}
+	rest = append([]string(nil), rest...)
+	s.reset()
+	return events, rest
+}
+
+// reset clears the pending keys
+func (s *KeySequencer) reset() {
+	s.pending = nil
+	s.exact = ""
+	s.matched = 0
+	s.timer.Stop()
+}
+
+// match returns the action of the sequence equal to keys, if any, and
+// whether keys are the start of a longer sequence
+func (s *KeySequencer) match(keys []string) (exact string, prefix bool) {
+	check := func(sequence, action string) {
+		tokens := s.parse(sequence)
+		if len(tokens) < len(keys) {
+			return
+		}
+		for i, key := range keys {
+			if tokens[i] != key {
+				return
+			}
+		}
+		if len(tokens) > len(keys) {
+			prefix = true
+		} else if exact == "" {
+			exact = action
+		}
+	}
+
+	for _, binding := range s.bindings {
+		check(binding.sequence, binding.action)
+	}
+	for _, keymap := range s.keymaps {
+		for _, binding := range keymap.bindings {
+			for _, sequence := range binding.Keys {
+				check(sequence, binding.Action)
+			}
+		}
+	}
+	return exact, prefix
+}
+
+// parse splits a sequence into keys
+func (s *KeySequencer) parse(sequence string) []string {
+	if sequence == " " {
+		return []string{" "}
+	}
+	tokens := strings.Fields(sequence)
+	for i, token := range tokens {
+		switch token {
+		case "<leader>":
+			tokens[i] = s.leader
+		case "space":
+			tokens[i] = " "
+		}
+	}
+	return tokens
+}
+
+// HandleKey feeds a key and passes the results to the OnAction and OnKey
+// handlers
+// Returns false only if the key went unhandled: it isn't part of a sequence
+// and there is no OnKey handler.
+func (s *KeySequencer) HandleKey(key string) bool {
+	events := s.Feed(key)
+	if s.onKey == nil && len(events) > 0 && events[len(events)-1] == (SequenceEvent{Key: key}) {
+		s.dispatch(events[:len(events)-1])
+		return false
+	}
+	s.dispatch(events)
+	return true
+}
+
+// HandleInput processes keyboard input
+func (s *KeySequencer) HandleInput(key string) {
+	s.HandleKey(key)
+}
+
+// dispatch passes events to the handlers
+func (s *KeySequencer) dispatch(events []SequenceEvent) {
+	for _, event := range events {
+		if event.Action != "" {
+			if s.onAction != nil {
+				s.onAction(event.Action)
+			}
+		} else if s.onKey != nil {
+			s.onKey(event.Key)
+		}
+	}
+}
+
+// TimerCmd returns a command that fires when the pending sequence times out
+func (s *KeySequencer) TimerCmd() tea.Cmd {
+	if len(s.pending) == 0 {
+		s.timer.Stop()
+		return nil
+	}
+	return s.timer.Schedule(s.lastKey.Add(s.timeout))
+}
+
+// HandleTimer settles the pending sequence when it times out and passes the
+// results to the handlers
+func (s *KeySequencer) HandleTimer(msg TimerMsg) tea.Cmd {
+	if !s.timer.Fired(msg) {
+		return nil
+	}
+	s.dispatch(s.Expire())
+	return s.TimerCmd()
+}
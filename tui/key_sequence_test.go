@@ -0,0 +1,187 @@
+package tui
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// feedKeys feeds keys to a sequencer and collects every event
+func feedKeys(s *KeySequencer, keys ...string) []SequenceEvent {
+	var events []SequenceEvent
+	for _, key := range keys {
+		events = append(events, s.Feed(key)...)
+	}
+	return events
+}
+
+func assertEvents(t *testing.T, got []SequenceEvent, want ...SequenceEvent) {
+	t.Helper()
+	if len(got) == 0 && len(want) == 0 {
+		return
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected events %+v, got %+v", want, got)
+	}
+}
+
+func TestKeySequencerChords(t *testing.T) {
+	s := NewKeySequencer()
+	s.Bind("g g", "top")
+	s.Bind("ctrl+x ctrl+s", "save")
+	s.Bind("<leader> f", "find")
+	s.Bind("space", "page-down")
+
+	assertEvents(t, feedKeys(s, "g"))
+	if s.PendingText() != "g" {
+		t.Errorf("Expected g to be pending, got %q", s.PendingText())
+	}
+	assertEvents(t, feedKeys(s, "g"), SequenceEvent{Action: "top"})
+	assertEvents(t, feedKeys(s, "ctrl+x", "ctrl+s"), SequenceEvent{Action: "save"})
+	assertEvents(t, feedKeys(s, "\\", "f"), SequenceEvent{Action: "find"})
+	assertEvents(t, feedKeys(s, " "), SequenceEvent{Action: "page-down"})
+
+	s.SetLeader(" ")
+	s.Feed(" ")
+	if s.PendingText() != "space" {
+		t.Errorf("Expected the leader to be pending, got %q", s.PendingText())
+	}
+	assertEvents(t, feedKeys(s, "f"), SequenceEvent{Action: "find"})
+}
+
+func TestKeySequencerPassesOtherKeysThrough(t *testing.T) {
+	s := NewKeySequencer()
+	s.Bind("g g", "top")
+	s.Bind("ctrl+x ctrl+s", "save")
+
+	assertEvents(t, feedKeys(s, "x"), SequenceEvent{Key: "x"})
+
+	// A broken sequence hands its keys back, and the new key starts over
+	assertEvents(t, feedKeys(s, "g", "x"), SequenceEvent{Key: "g"}, SequenceEvent{Key: "x"})
+	assertEvents(t, feedKeys(s, "g", "ctrl+x"), SequenceEvent{Key: "g"})
+	assertEvents(t, feedKeys(s, "ctrl+s"), SequenceEvent{Action: "save"})
+}
+
+func TestKeySequencerPrefixDisambiguation(t *testing.T) {
+	clock := NewFakeClock(testEpoch)
+	s := NewKeySequencer()
+	s.SetClock(clock)
+	s.SetTimeout(500 * time.Millisecond)
+	s.Bind("d", "delete")
+	s.Bind("d d", "delete-line")
+
+	// "d" alone waits to see if "d d" follows
+	assertEvents(t, feedKeys(s, "d"))
+	assertEvents(t, feedKeys(s, "d"), SequenceEvent{Action: "delete-line"})
+
+	// ...and fires on a key that doesn't continue it
+	assertEvents(t, feedKeys(s, "d", "j"), SequenceEvent{Action: "delete"}, SequenceEvent{Key: "j"})
+
+	// ...or when the timeout passes
+	s.Feed("d")
+	clock.Advance(499 * time.Millisecond)
+	assertEvents(t, s.Expire())
+	clock.Advance(time.Millisecond)
+	assertEvents(t, s.Expire(), SequenceEvent{Action: "delete"})
+
+	// A late second key starts a new sequence
+	s.Feed("d")
+	clock.Advance(time.Second)
+	assertEvents(t, feedKeys(s, "d"), SequenceEvent{Action: "delete"})
+	if s.PendingText() != "d" {
+		t.Errorf("Expected the late key to be pending, got %q", s.PendingText())
+	}
+}
+
+func TestKeySequencerRematchesAfterABrokenSequence(t *testing.T) {
+	s := NewKeySequencer()
+	s.Bind("a b c", "abc")
+	s.Bind("b d", "bd")
+
+	// "a b d" isn't a sequence, but "b d" after the "a" is
+	assertEvents(t, feedKeys(s, "a", "b", "d"), SequenceEvent{Key: "a"}, SequenceEvent{Action: "bd"})
+
+	// The longest completed sequence fires, not just the latest
+	s = NewKeySequencer()
+	s.Bind("g", "go")
+	s.Bind("g g g", "top")
+	assertEvents(t, feedKeys(s, "g", "g", "x"),
+		SequenceEvent{Action: "go"}, SequenceEvent{Action: "go"}, SequenceEvent{Key: "x"})
+	assertEvents(t, feedKeys(s, "g", "g", "g"), SequenceEvent{Action: "top"})
+
+	// Flushing settles everything pending
+	feedKeys(s, "g", "g")
+	assertEvents(t, s.Flush(), SequenceEvent{Action: "go"}, SequenceEvent{Action: "go"})
+	if s.PendingText() != "" {
+		t.Errorf("Expected nothing pending after a flush, got %q", s.PendingText())
+	}
+}
+
+func TestKeySequencerTimerDispatches(t *testing.T) {
+	clock := NewFakeClock(testEpoch)
+	s := NewKeySequencer()
+	s.SetClock(clock)
+	s.Bind("g g", "top")
+
+	var actions, keys []string
+	s.OnAction(func(action string) { actions = append(actions, action) })
+	s.OnKey(func(key string) { keys = append(keys, key) })
+
+	if !s.HandleKey("g") {
+		t.Error("Expected a pending key to be handled")
+	}
+	msg := runTimerCmd(t, clock, s.TimerCmd(), time.Second)
+	if s.HandleTimer(msg) != nil {
+		t.Error("Expected no timer once the sequence settled")
+	}
+	if len(keys) != 1 || keys[0] != "g" || len(actions) != 0 {
+		t.Errorf("Expected the timed out g to be passed on, got %v %v", keys, actions)
+	}
+
+	s.HandleKey("g")
+	s.HandleKey("g")
+	if len(actions) != 1 || actions[0] != "top" {
+		t.Errorf("Expected top, got %v", actions)
+	}
+
+	// Without an OnKey handler, unrelated keys are reported as unhandled
+	s.OnKey(nil)
+	if s.HandleKey("q") {
+		t.Error("Expected q to be unhandled")
+	}
+}
+
+func TestKeySequencerUsesKeymaps(t *testing.T) {
+	keymap := NewKeymap("editor",
+		KeyBinding{Action: "top", Keys: []string{"g g", "home"}, Help: "top"},
+		KeyBinding{Action: "bottom", Keys: []string{"G"}, Help: "bottom"},
+	)
+	s := NewKeySequencer()
+	s.AddKeymap(keymap)
+
+	assertEvents(t, feedKeys(s, "g", "g", "G", "home"),
+		SequenceEvent{Action: "top"}, SequenceEvent{Action: "bottom"}, SequenceEvent{Action: "top"})
+
+	keymap.SetKeys("top", "space t")
+	assertEvents(t, feedKeys(s, " ", "t"), SequenceEvent{Action: "top"})
+}
+
+func TestStatusBarShowsPendingKeys(t *testing.T) {
+	s := NewKeySequencer()
+	s.Bind("ctrl+x ctrl+s", "save")
+	sb := NewStatusBar()
+	sb.AddSegment("NORMAL", "left")
+	sb.AddDynamicSegment(s.PendingText, "left")
+
+	screen := NewScreenSimulation(30, 1)
+	sb.Draw(screen.Screen, 0, 0, 30, 1, NewTestTheme())
+	AssertTextNotExists(t, screen, "|")
+	if width, _ := sb.Measure(30, 1); width != 6 {
+		t.Errorf("Expected empty dynamic segments to take no space, got %d", width)
+	}
+
+	s.Feed("ctrl+x")
+	screen.Clear()
+	sb.Draw(screen.Screen, 0, 0, 30, 1, NewTestTheme())
+	AssertTextExists(t, screen, "NORMAL | ctrl+x")
+}
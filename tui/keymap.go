@@ -0,0 +1,262 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// KeyBinding is a named action and the keys that trigger it
+type KeyBinding struct {
+	Action string   // Name used in code and config, such as "delete-row"
+	Keys   []string // Key strings as reported by bubbletea, such as "ctrl+k"
+	Help   string   // Short description for help views; empty hides the binding
+}
+
+// KeyHelp is a binding as shown in help views
+type KeyHelp struct {
+	Keys        string // Keys joined with "/", such as "up/k"
+	Description string
+}
+
+// KeymapProvider is implemented by components with configurable key bindings
+type KeymapProvider interface {
+	Keymap() *Keymap
+}
+
+// Keymap maps keys to named actions
+//
+// Bindings keep the order they were defined in, which is the order help is
+// generated in. When several actions share a key, the first one wins.
+type Keymap struct {
+	name     string
+	bindings []KeyBinding
+}
+
+// NewKeymap creates a keymap with a name, used to find its section in
+// config files, and its default bindings
+func NewKeymap(name string, bindings ...KeyBinding) *Keymap {
+	k := &Keymap{name: name}
+	for _, binding := range bindings {
+		k.Bind(binding.Action, binding.Help, binding.Keys...)
+	}
+	return k
+}
+
+// Name returns the keymap's name
+func (k *Keymap) Name() string {
+	return k.name
+}
+
+// Bind adds an action, or replaces the help and keys of an existing one
+func (k *Keymap) Bind(action, help string, keys ...string) {
+	keys = append([]string(nil), keys...)
+	if i := k.indexOf(action); i >= 0 {
+		k.bindings[i].Help = help
+		k.bindings[i].Keys = keys
+		return
+	}
+	k.bindings = append(k.bindings, KeyBinding{Action: action, Keys: keys, Help: help})
+}
+
+// SetKeys rebinds an action to different keys
+// The keys are taken away from any other action that had them; pass no keys
+// to disable the action. Returns an error if the action doesn't exist.
+func (k *Keymap) SetKeys(action string, keys ...string) error {
+	i := k.indexOf(action)
+	if i < 0 {
+		return fmt.Errorf("tui: keymap %q has no action %q", k.name, action)
+	}
+	for j := range k.bindings {
+		if j != i {
+			k.bindings[j].Keys = without(k.bindings[j].Keys, keys)
+		}
+	}
+	k.bindings[i].Keys = append([]string(nil), keys...)
+	return nil
+}
+
+// without returns the keys that aren't in remove
+func without(keys, remove []string) []string {
+	var kept []string
+	for _, key := range keys {
+		found := false
+		for _, r := range remove {
+			if key == r {
+				found = true
+				break
+			}
+		}
+		if !found {
+			kept = append(kept, key)
+		}
+	}
+	return kept
+}
+
+// Action returns the action bound to a key, or "" if there is none
+func (k *Keymap) Action(key string) string {
+	if k == nil {
+		return ""
+	}
+	for _, binding := range k.bindings {
+		for _, bound := range binding.Keys {
+			if bound == key {
+				return binding.Action
+			}
+		}
+	}
+	return ""
+}
+
+// Matches returns whether a key triggers an action
+func (k *Keymap) Matches(key, action string) bool {
+	return key != "" && k.Action(key) == action
+}
+
+// Keys returns the keys bound to an action
+func (k *Keymap) Keys(action string) []string {
+	if i := k.indexOf(action); i >= 0 {
+		return append([]string(nil), k.bindings[i].Keys...)
+	}
+	return nil
+}
+
+// Bindings returns the bindings in definition order
+func (k *Keymap) Bindings() []KeyBinding {
+	bindings := make([]KeyBinding, len(k.bindings))
+	for i, binding := range k.bindings {
+		binding.Keys = append([]string(nil), binding.Keys...)
+		bindings[i] = binding
+	}
+	return bindings
+}
+
+// Clone returns an independent copy of the keymap
+func (k *Keymap) Clone() *Keymap {
+	return &Keymap{name: k.name, bindings: k.Bindings()}
+}
+
+// Apply rebinds actions from a map of action names to keys
+// Actions are applied in sorted order so the result doesn't depend on map
+// iteration. Unknown actions are reported after the others are applied.
+func (k *Keymap) Apply(overrides map[string][]string) error {
+	actions := make([]string, 0, len(overrides))
+	for action := range overrides {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+
+	var unknown []string
+	for _, action := range actions {
+		if k.SetKeys(action, overrides[action]...) != nil {
+			unknown = append(unknown, action)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("tui: keymap %q has no actions %s", k.name, strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// Help returns the bindings that have help text and keys, in definition
+// order
+func (k *Keymap) Help() []KeyHelp {
+	var help []KeyHelp
+	for _, binding := range k.bindings {
+		if binding.Help == "" || len(binding.Keys) == 0 {
+			continue
+		}
+		help = append(help, KeyHelp{
+			Keys:        strings.Join(binding.Keys, "/"),
+			Description: binding.Help,
+		})
+	}
+	return help
+}
+
+// indexOf returns the position of an action, or -1
+func (k *Keymap) indexOf(action string) int {
+	if k == nil {
+		return -1
+	}
+	for i, binding := range k.bindings {
+		if binding.Action == action {
+			return i
+		}
+	}
+	return -1
+}
+
+// LoadKeymaps applies user overrides from a JSON config to keymaps
+//
+// The config maps keymap names to actions to keys:
+//
+//	{"table": {"delete-row": ["x"], "up": ["up", "ctrl+p"]}}
+//
+// Sections for keymaps that weren't passed are ignored.
+func LoadKeymaps(r io.Reader, keymaps ...*Keymap) error {
+	var config map[string]map[string][]string
+	if err := json.NewDecoder(r).Decode(&config); err != nil {
+		return fmt.Errorf("tui: reading keymap config: %w", err)
+	}
+	for _, keymap := range keymaps {
+		if overrides, ok := config[keymap.name]; ok {
+			if err := keymap.Apply(overrides); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// KeyHints returns compact "keys:description" hints for a status bar
+func KeyHints(keymaps ...*Keymap) string {
+	var parts []string
+	for _, keymap := range keymaps {
+		for _, help := range keymap.Help() {
+			parts = append(parts, help.Keys+":"+help.Description)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// KeymapHelpText returns a help listing with one binding per line and the
+// descriptions aligned, with a heading for each keymap
+func KeymapHelpText(keymaps ...*Keymap) string {
+	width := 0
+	for _, keymap := range keymaps {
+		for _, help := range keymap.Help() {
+			width = max(width, StringWidth(help.Keys))
+		}
+	}
+
+	var sections []string
+	for _, keymap := range keymaps {
+		help := keymap.Help()
+		if len(help) == 0 {
+			continue
+		}
+		lines := []string{keymap.name}
+		for _, h := range help {
+			lines = append(lines, "  "+FillRight(h.Keys, width)+"  "+h.Description)
+		}
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+	return strings.Join(sections, "\n\n")
+}
+
+// lineEditBindings are the cursor and deletion bindings shared by
+// single-line text editing
+func lineEditBindings() []KeyBinding {
+	return []KeyBinding{
+		{Action: "cursor-left", Keys: []string{"left", "ctrl+b"}, Help: "move left"},
+		{Action: "cursor-right", Keys: []string{"right", "ctrl+f"}, Help: "move right"},
+		{Action: "line-start", Keys: []string{"home", "ctrl+a"}, Help: "start of line"},
+		{Action: "line-end", Keys: []string{"end", "ctrl+e"}, Help: "end of line"},
+		{Action: "delete-back", Keys: []string{"backspace", "ctrl+h"}, Help: "delete back"},
+		{Action: "delete-forward", Keys: []string{"delete", "ctrl+d"}, Help: "delete forward"},
+	}
+}
@@ -0,0 +1,155 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKeymapLookup(t *testing.T) {
+	keymap := NewKeymap("test",
+		KeyBinding{Action: "up", Keys: []string{"up", "k"}, Help: "up"},
+		KeyBinding{Action: "down", Keys: []string{"down", "j"}, Help: "down"},
+		KeyBinding{Action: "also-up", Keys: []string{"k"}},
+	)
+
+	if keymap.Action("k") != "up" {
+		t.Errorf("Expected the first binding to win, got %q", keymap.Action("k"))
+	}
+	if keymap.Action("x") != "" || keymap.Matches("", "up") {
+		t.Error("Expected unbound keys to have no action")
+	}
+	if !keymap.Matches("j", "down") {
+		t.Error("Expected j to match down")
+	}
+
+	// Rebinding takes keys away from other actions
+	if err := keymap.SetKeys("down", "k", "ctrl+n"); err != nil {
+		t.Fatal(err)
+	}
+	if keymap.Action("k") != "down" || keymap.Action("j") != "" {
+		t.Errorf("Expected k to move to down, got %q", keymap.Action("k"))
+	}
+	if keys := keymap.Keys("up"); len(keys) != 1 || keys[0] != "up" {
+		t.Errorf("Expected up to keep only the up key, got %v", keys)
+	}
+	if err := keymap.SetKeys("sideways", "s"); err == nil {
+		t.Error("Expected an error for an unknown action")
+	}
+}
+
+func TestKeymapCloneIsIndependent(t *testing.T) {
+	original := DefaultTableKeymap()
+	clone := original.Clone()
+	clone.SetKeys("delete-row", "x")
+
+	if original.Action("x") != "" || original.Action("d") != "delete-row" {
+		t.Error("Expected the original keymap to be unchanged")
+	}
+}
+
+func TestLoadKeymaps(t *testing.T) {
+	table := NewTable()
+	table.SetColumns([]TableColumn{{Title: "Name", Width: 10}})
+	table.SetRows([]TableRow{{"a"}, {"b"}})
+
+	config := `{
+		"table": {"delete-row": ["x"], "down": ["ctrl+n"]},
+		"unused": {"anything": ["y"]}
+	}`
+	if err := LoadKeymaps(strings.NewReader(config), table.Keymap()); err != nil {
+		t.Fatal(err)
+	}
+
+	table.HandleInput("d")
+	table.HandleInput("j")
+	if len(table.rows) != 2 || table.selectedRow != 0 {
+		t.Error("Expected the old keys to do nothing")
+	}
+	table.HandleInput("ctrl+n")
+	table.HandleInput("x")
+	if len(table.rows) != 1 || table.rows[0][0] != "a" {
+		t.Errorf("Expected the overridden keys to delete the second row, got %v", table.rows)
+	}
+
+	err := LoadKeymaps(strings.NewReader(`{"table": {"fly": ["f"]}}`), table.Keymap())
+	if err == nil || !strings.Contains(err.Error(), "fly") {
+		t.Errorf("Expected an unknown action error, got %v", err)
+	}
+	if err := LoadKeymaps(strings.NewReader(`{`), table.Keymap()); err == nil {
+		t.Error("Expected an error for invalid JSON")
+	}
+}
+
+func TestComponentKeymapOverrides(t *testing.T) {
+	input := NewInput()
+	input.SetValue("hello")
+	input.Keymap().SetKeys("delete-to-start", "ctrl+w")
+	input.HandleInput("ctrl+u") // No longer bound, and not printable
+	if input.Value() != "hello" {
+		t.Errorf("Expected ctrl+u to be unbound, got %q", input.Value())
+	}
+	input.HandleInput("ctrl+w")
+	if input.Value() != "" {
+		t.Errorf("Expected ctrl+w to clear the input, got %q", input.Value())
+	}
+
+	tabs := NewTabs()
+	tabs.AddTab("One", "1")
+	tabs.AddTab("Two", "2")
+	tabs.Keymap().SetKeys("tab-2", "alt+2")
+	tabs.HandleInput("alt+2")
+	if tabs.GetActive() != 1 {
+		t.Errorf("Expected alt+2 to select the second tab, got %d", tabs.GetActive())
+	}
+
+	dialog := Confirm("Quit", "Quit?")
+	dialog.Keymap().SetKeys("cancel", "q")
+	dialog.Show()
+	dialog.HandleKey("esc")
+	if !dialog.IsVisible() {
+		t.Error("Expected esc to be unbound")
+	}
+	dialog.HandleKey("q")
+	if result, _ := dialog.Result(); !result.Cancelled {
+		t.Errorf("Expected q to cancel, got %+v", result)
+	}
+}
+
+func TestKeymapHelp(t *testing.T) {
+	viewer := DefaultViewerKeymap()
+	tabs := DefaultTabsKeymap()
+
+	help := viewer.Help()
+	if len(help) != 6 || help[0].Keys != "up/k" || help[5].Description != "bottom" {
+		t.Errorf("Expected help in definition order, got %+v", help)
+	}
+	if got := len(tabs.Help()); got != 2 {
+		t.Errorf("Expected bindings without help to be hidden, got %d", got)
+	}
+
+	hints := KeyHints(tabs)
+	if hints != "left/h:previous tab right/l:next tab" {
+		t.Errorf("Unexpected hints %q", hints)
+	}
+
+	text := KeymapHelpText(tabs, viewer)
+	lines := strings.Split(text, "\n")
+	if lines[0] != "tabs" || lines[1] != "  left/h          previous tab" || lines[4] != "viewer" {
+		t.Errorf("Unexpected help text:\n%s", text)
+	}
+}
+
+func TestHelpStatusBarIsStable(t *testing.T) {
+	shortcuts := map[string]string{"q": "quit", "?": "help", "/": "search", "n": "new"}
+	for i := 0; i < 10; i++ {
+		sb := NewHelpStatusBar(shortcuts)
+		if sb.segments[0].Text != "/:search ?:help n:new q:quit" {
+			t.Fatalf("Expected sorted shortcuts, got %q", sb.segments[0].Text)
+		}
+	}
+
+	sb := NewKeymapStatusBar(DefaultNotificationKeymap())
+	if sb.segments[0].Text != "esc/enter:dismiss" {
+		t.Errorf("Unexpected keymap hints %q", sb.segments[0].Text)
+	}
+}
@@ -12,6 +12,24 @@ type Rectangle struct {
 	X, Y, Width, Height int
 }
 
+// Contains returns whether the point lies inside the rectangle
+func (r Rectangle) Contains(x, y int) bool {
+	return x >= r.X && x < r.X+r.Width && y >= r.Y && y < r.Y+r.Height
+}
+
+// Intersect returns the overlapping area of two rectangles
+// The result has zero width and height when they do not overlap
+func (r Rectangle) Intersect(other Rectangle) Rectangle {
+	x1 := max(r.X, other.X)
+	y1 := max(r.Y, other.Y)
+	x2 := min(r.X+r.Width, other.X+other.Width)
+	y2 := min(r.Y+r.Height, other.Y+other.Height)
+	if x2 <= x1 || y2 <= y1 {
+		return Rectangle{X: x1, Y: y1}
+	}
+	return Rectangle{X: x1, Y: y1, Width: x2 - x1, Height: y2 - y1}
+}
+
 // LayoutDirection represents the direction of a layout
 type LayoutDirection int
 
@@ -0,0 +1,288 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// LayoutNode is a component in the recorded layout tree
+type LayoutNode struct {
+	Type       string        `json:"type"`
+	X          int           `json:"x"`
+	Y          int           `json:"y"`
+	Width      int           `json:"width"`
+	Height     int           `json:"height"`
+	Constraint string        `json:"constraint,omitempty"`
+	Children   []*LayoutNode `json:"children,omitempty"`
+}
+
+// BuildLayoutTree turns recorded component rectangles into a tree
+// Records whose parent isn't in the slice become top-level nodes.
+func BuildLayoutTree(rects []ComponentRect) []*LayoutNode {
+	nodes := make([]*LayoutNode, len(rects))
+	for i, drawn := range rects {
+		nodes[i] = &LayoutNode{
+			Type:       componentTypeName(drawn.Component),
+			X:          drawn.Rect.X,
+			Y:          drawn.Rect.Y,
+			Width:      drawn.Rect.Width,
+			Height:     drawn.Rect.Height,
+			Constraint: drawn.Constraint,
+		}
+	}
+
+	var roots []*LayoutNode
+	for i, drawn := range rects {
+		if drawn.Parent >= 0 && drawn.Parent < len(nodes) && drawn.Parent != i {
+			parent := nodes[drawn.Parent]
+			parent.Children = append(parent.Children, nodes[i])
+		} else {
+			roots = append(roots, nodes[i])
+		}
+	}
+	return roots
+}
+
+// LayoutTree returns the layout tree recorded since the last Clear
+// Rect tracking must be enabled (see SetRectTracking).
+func (s *Screen) LayoutTree() []*LayoutNode {
+	return BuildLayoutTree(s.DrawnRects())
+}
+
+// DumpLayoutTree formats a layout tree as indented text, one node per line:
+//
+//	LinearLayout 0,0 80x24
+//	  Container 0,0 80x3 [Auto]
+func DumpLayoutTree(nodes []*LayoutNode) string {
+	var builder strings.Builder
+	var dump func(nodes []*LayoutNode, depth int)
+	dump = func(nodes []*LayoutNode, depth int) {
+		for _, node := range nodes {
+			builder.WriteString(strings.Repeat("  ", depth))
+			fmt.Fprintf(&builder, "%s %d,%d %dx%d", node.Type, node.X, node.Y, node.Width, node.Height)
+			if node.Constraint != "" {
+				builder.WriteString(" [" + node.Constraint + "]")
+			}
+			builder.WriteByte('\n')
+			dump(node.Children, depth+1)
+		}
+	}
+	dump(nodes, 0)
+	return builder.String()
+}
+
+// LayoutTreeJSON formats a layout tree as indented JSON
+func LayoutTreeJSON(nodes []*LayoutNode) (string, error) {
+	data, err := json.MarshalIndent(nodes, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// componentTypeName returns a short type name such as "LinearLayout"
+func componentTypeName(component Component) string {
+	name := fmt.Sprintf("%T", component)
+	name = strings.TrimPrefix(name, "*")
+	if dot := strings.LastIndex(name, "."); dot >= 0 {
+		name = name[dot+1:]
+	}
+	return name
+}
+
+// LayoutDebug wraps a component and records the rectangle every descendant
+// is drawn into. When enabled, it outlines and labels those rectangles on
+// top of the normal output.
+type LayoutDebug struct {
+	content    Component
+	enabled    bool
+	showLabels bool
+	tree       []*LayoutNode
+	width      int
+	height     int
+}
+
+// NewLayoutDebug creates a layout debugger around a component
+func NewLayoutDebug(content Component) *LayoutDebug {
+	return &LayoutDebug{
+		content:    content,
+		enabled:    false,
+		showLabels: true,
+	}
+}
+
+// SetContent sets the wrapped component
+func (d *LayoutDebug) SetContent(content Component) {
+	d.content = content
+}
+
+// SetEnabled sets whether the overlay is drawn
+func (d *LayoutDebug) SetEnabled(enabled bool) {
+	d.enabled = enabled
+}
+
+// Toggle switches the overlay on or off
+func (d *LayoutDebug) Toggle() {
+	d.enabled = !d.enabled
+}
+
+// IsEnabled returns whether the overlay is drawn
+func (d *LayoutDebug) IsEnabled() bool {
+	return d.enabled
+}
+
+// SetShowLabels sets whether rectangles are labeled with type and size
+func (d *LayoutDebug) SetShowLabels(show bool) {
+	d.showLabels = show
+}
+
+// Tree returns the layout tree recorded during the last draw
+func (d *LayoutDebug) Tree() []*LayoutNode {
+	return d.tree
+}
+
+// Dump returns the layout tree recorded during the last draw as text
+func (d *LayoutDebug) Dump() string {
+	return DumpLayoutTree(d.tree)
+}
+
+// JSON returns the layout tree recorded during the last draw as JSON
+func (d *LayoutDebug) JSON() (string, error) {
+	return LayoutTreeJSON(d.tree)
+}
+
+// Draw renders the wrapped component and, when enabled, the overlay
+func (d *LayoutDebug) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	d.width = availableWidth
+	d.height = availableHeight
+	if d.content == nil {
+		d.tree = nil
+		return
+	}
+
+	// Track only while drawing the content, leaving the screen as it was
+	// for later frames
+	defer screen.SetRectTracking(screen.rootScreen().trackRects)
+	screen.SetRectTracking(true)
+	start := len(screen.DrawnRects())
+	screen.DrawComponent(d.content, x, y, availableWidth, availableHeight, theme)
+
+	// Only keep what was drawn inside this debugger, re-rooted at index 0
+	recorded := screen.DrawnRects()[start:]
+	rects := make([]ComponentRect, len(recorded))
+	for i, drawn := range recorded {
+		drawn.Parent -= start
+		rects[i] = drawn
+	}
+	d.tree = BuildLayoutTree(rects)
+
+	if d.enabled {
+		originX, originY := screen.Origin()
+		drawLayoutOverlay(screen, d.tree, -originX, -originY, 0, d.showLabels, theme)
+	}
+}
+
+// layoutDebugColors returns the outline colors, cycled by tree depth
+func layoutDebugColors(theme *Theme) []lipgloss.TerminalColor {
+	return []lipgloss.TerminalColor{
+		theme.Palette.Love,
+		theme.Palette.Gold,
+		theme.Palette.Pine,
+		theme.Palette.Foam,
+		theme.Palette.Iris,
+		theme.Palette.Rose,
+	}
+}
+
+// drawLayoutOverlay outlines each node's rectangle, translated by dx/dy
+func drawLayoutOverlay(screen *Screen, nodes []*LayoutNode, dx, dy, depth int, showLabels bool, theme *Theme) {
+	colors := layoutDebugColors(theme)
+	for _, node := range nodes {
+		style := lipgloss.NewStyle().Foreground(colors[depth%len(colors)])
+		x, y := node.X+dx, node.Y+dy
+
+		if node.Width >= 2 && node.Height >= 2 {
+			screen.DrawBox(x, y, node.Width, node.Height, style)
+		} else {
+			// Too small for a box; mark the cells instead
+			for row := 0; row < node.Height; row++ {
+				for col := 0; col < node.Width; col++ {
+					screen.DrawRune(x+col, y+row, '·', style)
+				}
+			}
+		}
+
+		if showLabels && node.Width > 2 {
+			label := fmt.Sprintf("%s %dx%d", node.Type, node.Width, node.Height)
+			label = Truncate(label, node.Width-2)
+			screen.DrawString(x+1, y, label, style.Bold(true))
+		}
+
+		drawLayoutOverlay(screen, node.Children, dx, dy, depth+1, showLabels, theme)
+	}
+}
+
+// HandleInput passes input to the wrapped component
+func (d *LayoutDebug) HandleInput(key string) {
+	if d.content != nil {
+		d.content.HandleInput(key)
+	}
+}
+
+// Focus gives keyboard focus to the wrapped component
+func (d *LayoutDebug) Focus() {
+	if focusable, ok := d.content.(Focusable); ok {
+		focusable.Focus()
+	}
+}
+
+// Blur removes keyboard focus from the wrapped component
+func (d *LayoutDebug) Blur() {
+	if focusable, ok := d.content.(Focusable); ok {
+		focusable.Blur()
+	}
+}
+
+// IsFocused returns whether the wrapped component has focus
+func (d *LayoutDebug) IsFocused() bool {
+	if focusable, ok := d.content.(Focusable); ok {
+		return focusable.IsFocused()
+	}
+	return false
+}
+
+// HandleKey passes keyboard input to the wrapped component
+func (d *LayoutDebug) HandleKey(key string) bool {
+	if handler, ok := d.content.(interface{ HandleKey(string) bool }); ok {
+		return handler.HandleKey(key)
+	}
+	return false
+}
+
+// ForwardsFocus reports that the debugger only passes focus on to its
+// children
+func (d *LayoutDebug) ForwardsFocus() bool {
+	return true
+}
+
+// Children returns the wrapped component
+func (d *LayoutDebug) Children() []Component {
+	if d.content != nil {
+		return []Component{d.content}
+	}
+	return nil
+}
+
+// SetSize sets the width and height of the component
+func (d *LayoutDebug) SetSize(width, height int) {
+	d.width = width
+	d.height = height
+}
+
+// GetSize returns the current width and height
+func (d *LayoutDebug) GetSize() (width, height int) {
+	return d.width, d.height
+}
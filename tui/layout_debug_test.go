@@ -0,0 +1,103 @@
+package tui
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLayoutTreeRecordsRectsAndConstraints(t *testing.T) {
+	screen := NewScreenSimulation(40, 10)
+	screen.SetRectTracking(true)
+	theme := NewTestTheme()
+
+	sidebar := &mockComponent{}
+	content := &mockComponent{}
+	body := HBox()
+	body.AddFixed(sidebar, 10)
+	body.AddFlex(content, 1)
+
+	layout := VBox()
+	layout.AddFixed(&mockComponent{}, 2)
+	layout.AddFlex(body, 1)
+
+	screen.DrawComponent(layout, 0, 0, 40, 10, theme)
+
+	expected := "LinearLayout 0,0 40x10\n" +
+		"  mockComponent 0,0 40x2 [Length(2)]\n" +
+		"  LinearLayout 0,2 40x8 [Ratio(1)]\n" +
+		"    mockComponent 0,2 10x8 [Length(10)]\n" +
+		"    mockComponent 10,2 30x8 [Ratio(1)]\n"
+	if actual := DumpLayoutTree(screen.LayoutTree()); actual != expected {
+		t.Errorf("Layout tree mismatch\nExpected:\n%s\nActual:\n%s", expected, actual)
+	}
+}
+
+func TestLayoutTreeJSON(t *testing.T) {
+	screen := NewScreenSimulation(20, 5)
+	screen.SetRectTracking(true)
+	theme := NewTestTheme()
+
+	split := NewVSplit()
+	split.SetPercentage(0.25)
+	split.SetFirst(&mockComponent{})
+	split.SetSecond(&mockComponent{})
+	screen.DrawComponent(split, 0, 0, 20, 5, theme)
+
+	output, err := LayoutTreeJSON(screen.LayoutTree())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var nodes []LayoutNode
+	if err := json.Unmarshal([]byte(output), &nodes); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if len(nodes) != 1 || len(nodes[0].Children) != 2 {
+		t.Fatalf("Expected one split with two panes, got %s", output)
+	}
+	first, second := nodes[0].Children[0], nodes[0].Children[1]
+	if first.Width != 5 || first.Constraint != "Percentage(25%)" {
+		t.Errorf("Unexpected first pane: %+v", first)
+	}
+	if second.X != 5 || second.Width != 15 || second.Constraint != "Remaining" {
+		t.Errorf("Unexpected second pane: %+v", second)
+	}
+}
+
+func TestLayoutDebugOverlay(t *testing.T) {
+	screen := NewScreenSimulation(62, 8)
+	theme := NewTestTheme()
+
+	layout := HBox()
+	layout.SetPadding(NewMargin(1))
+	layout.AddFlex(&mockComponent{}, 1)
+	layout.AddFlex(&mockComponent{}, 1)
+
+	debug := NewLayoutDebug(layout)
+	debug.Draw(screen.Screen, 0, 0, 62, 8, theme)
+
+	if screen.CountOccurrences('┌') != 0 {
+		t.Error("Expected no overlay while disabled")
+	}
+	if len(debug.Tree()) != 1 {
+		t.Errorf("Expected tree to be recorded while disabled, got %d roots", len(debug.Tree()))
+	}
+
+	debug.Toggle()
+	screen.Clear()
+	debug.Draw(screen.Screen, 0, 0, 62, 8, theme)
+
+	// Outlines for the layout and both children
+	if count := screen.CountOccurrences('┌'); count != 3 {
+		t.Errorf("Expected 3 outlines, got %d", count)
+	}
+	AssertTextExists(t, screen, "LinearLayout 62x8")
+	AssertTextExists(t, screen, "mockComponent 30x6")
+
+	// Rect tracking is turned back off for frames drawn without the debugger
+	screen.Clear()
+	screen.DrawComponent(layout, 0, 0, 62, 8, theme)
+	if rects := screen.DrawnRects(); len(rects) != 0 {
+		t.Errorf("Expected rect tracking restored, got %d rects", len(rects))
+	}
+}
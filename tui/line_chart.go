@@ -0,0 +1,208 @@
+package tui
+
+import (
+	"math"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// LineChart plots one or more series as lines over time
+//
+// Lines are drawn with braille dots, two across and four down per cell, and
+// each value takes one dot column with the newest on the right. Series are
+// colored from the palette, and a legend names them when any has a name.
+// The value axis is scaled to the values shown unless fixed with SetRange.
+type LineChart struct {
+	series     []*Series
+	scale      chartScale
+	format     func(value float64) string
+	showLegend bool
+	showAxes   bool
+	startLabel string
+	endLabel   string
+}
+
+// NewLineChart creates a line chart of the given series
+func NewLineChart(series ...*Series) *LineChart {
+	return &LineChart{
+		series:     series,
+		format:     FormatChartValue,
+		showLegend: true,
+		showAxes:   true,
+	}
+}
+
+// AddSeries adds a series to plot
+func (c *LineChart) AddSeries(series *Series) {
+	c.series = append(c.series, series)
+}
+
+// Series returns the series plotted
+func (c *LineChart) Series() []*Series {
+	return c.series
+}
+
+// SetRange fixes the values at the bottom and top of the chart
+func (c *LineChart) SetRange(low, high float64) {
+	c.scale = chartScale{low: low, high: high, fixed: true}
+}
+
+// SetAutoRange scales the chart to the values shown, the default
+func (c *LineChart) SetAutoRange() {
+	c.scale = chartScale{}
+}
+
+// SetFormat sets how the value axis labels are written
+func (c *LineChart) SetFormat(format func(value float64) string) {
+	if format == nil {
+		format = FormatChartValue
+	}
+	c.format = format
+}
+
+// SetShowLegend sets whether named series are listed above the plot
+func (c *LineChart) SetShowLegend(show bool) {
+	c.showLegend = show
+}
+
+// SetShowAxes sets whether the value axis and baseline are drawn
+func (c *LineChart) SetShowAxes(show bool) {
+	c.showAxes = show
+}
+
+// SetTimeLabels sets the text under the oldest and newest ends of the
+// baseline, like "-60s" and "now"
+func (c *LineChart) SetTimeLabels(start, end string) {
+	c.startLabel, c.endLabel = start, end
+}
+
+// hasLegend returns whether a legend row is drawn
+func (c *LineChart) hasLegend() bool {
+	if !c.showLegend {
+		return false
+	}
+	for _, series := range c.series {
+		if series.Name() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Measure returns sixty columns and twelve rows, or less if that's all there
+// is
+func (c *LineChart) Measure(maxWidth, maxHeight int) (width, height int) {
+	return min(60, maxWidth), min(12, maxHeight)
+}
+
+// Draw renders the legend, axes and lines
+func (c *LineChart) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	if availableWidth <= 0 || availableHeight <= 0 {
+		return
+	}
+	axisStyle := lipgloss.NewStyle().Foreground(theme.Palette.Border)
+	labelStyle := lipgloss.NewStyle().Foreground(theme.Palette.TextMuted)
+
+	plotX, plotY, plotWidth, plotHeight := x, y, availableWidth, availableHeight
+	if c.hasLegend() && plotHeight > 1 {
+		c.drawLegend(screen, x, y, availableWidth, theme)
+		plotY++
+		plotHeight--
+	}
+
+	visible, low, high := c.visible(plotWidth * 2)
+	if c.showAxes && plotHeight > 2 {
+		// The axis labels take room from the plot, which can change the
+		// values that fit and so the range
+		labelWidth := max(StringWidth(c.format(low)), StringWidth(c.format(high)))
+		visible, low, high = c.visible((plotWidth - labelWidth - 1) * 2)
+
+		plotHeight--
+		baseline := plotY + plotHeight
+		offset := drawValueAxis(screen, x, plotY, plotHeight, low, high, c.format, axisStyle, labelStyle)
+		screen.DrawRune(x+offset, baseline, '└', axisStyle)
+		for col := x + offset + 1; col < x+availableWidth; col++ {
+			screen.DrawRune(col, baseline, '─', axisStyle)
+		}
+		plotX = x + offset + 1
+		plotWidth = x + availableWidth - plotX
+		if c.startLabel != "" {
+			screen.DrawString(plotX+1, baseline, Truncate(" "+c.startLabel+" ", plotWidth-1), labelStyle)
+		}
+		if c.endLabel != "" {
+			end := " " + c.endLabel + " "
+			screen.DrawString(plotX+plotWidth-StringWidth(end)-1, baseline, end, labelStyle)
+		}
+	}
+	if plotWidth <= 0 {
+		return
+	}
+	c.drawLines(screen, plotX, plotY, plotWidth, plotHeight, visible, low, high, theme)
+}
+
+// visible returns the most recent values of each series that fit in the
+// given dot columns, and the range they're drawn in
+func (c *LineChart) visible(dotColumns int) (visible [][]float64, low, high float64) {
+	var all []float64
+	for _, series := range c.series {
+		values := series.Values()
+		values = values[max(0, len(values)-max(0, dotColumns)):]
+		visible = append(visible, values)
+		all = append(all, values...)
+	}
+	low, high = c.scale.bounds(all, false)
+	return visible, low, high
+}
+
+// drawLegend lists the named series, each after a dot in its color
+func (c *LineChart) drawLegend(screen *Screen, x, y, width int, theme *Theme) {
+	textStyle := lipgloss.NewStyle().Foreground(theme.Palette.TextMuted)
+	col, right := x, x+width
+	for i, series := range c.series {
+		if series.Name() == "" {
+			continue
+		}
+		entry := "● " + series.Name()
+		if col+StringWidth(entry) > right {
+			return
+		}
+		color := seriesRole(series.Role(), i).Color(theme.Palette)
+		screen.DrawRune(col, y, '●', lipgloss.NewStyle().Foreground(color))
+		screen.DrawString(col+2, y, series.Name(), textStyle)
+		col += StringWidth(entry) + 2
+	}
+}
+
+// drawLines plots each series with braille dots
+// Where series cross, the cell takes the color of the later one.
+func (c *LineChart) drawLines(screen *Screen, x, y, width, height int, visible [][]float64, low, high float64, theme *Theme) {
+	painter := newCanvasPainter(CanvasBraille, width, height)
+	dotRows := painter.Height()
+	dotColumns := painter.Width()
+
+	for i, values := range visible {
+		role := seriesRole(c.series[i].Role(), i)
+		values = values[max(0, len(values)-dotColumns):]
+		first := dotColumns - len(values)
+		previous := -1
+		for j, value := range values {
+			if math.IsNaN(value) || math.IsInf(value, 0) {
+				// A gap in the data breaks the line
+				previous = -1
+				continue
+			}
+			dotX := first + j
+			dotY := int(math.Round((1 - fraction(value, low, high)) * float64(dotRows-1)))
+			if previous < 0 {
+				previous = dotY
+			}
+			// Join to the last point by filling the column between them
+			painter.Line(dotX, previous, dotX, dotY, role)
+			previous = dotY
+		}
+	}
+	painter.render(screen, x, y, theme)
+}
+
+// HandleInput does nothing; line charts don't take input
+func (c *LineChart) HandleInput(key string) {}
@@ -6,6 +6,7 @@ type LinearLayout struct {
 	items  []LinearItem
 	width  int
 	height int
+	err    error // Result of solving the constraints on the last draw
 }
 
 // LinearItem represents an item in a linear layout
@@ -50,6 +51,11 @@ func (l *LinearLayout) AddFixed(component Component, size int) {
 	l.Add(component, NewConstraintSet(NewLength(size)))
 }
 
+// AddAuto adds a component sized to its measured size along the layout direction
+func (l *LinearLayout) AddAuto(component Component) {
+	l.Add(component, NewConstraintSet(NewAuto()))
+}
+
 // AddFlex adds a component with a ratio constraint
 func (l *LinearLayout) AddFlex(component Component, flex float64) {
 	l.Add(component, NewConstraintSet(NewRatio(flex)))
@@ -60,6 +66,12 @@ func (l *LinearLayout) AddPercentage(component Component, pct float64) {
 	l.Add(component, NewConstraintSet(NewPercentage(pct)))
 }
 
+// LayoutError returns the error from solving the constraints on the last draw
+// It is an *UnsatisfiableError when required sizes didn't fit, or nil.
+func (l *LinearLayout) LayoutError() error {
+	return l.err
+}
+
 // SetSize sets the width and height of the layout
 func (l *LinearLayout) SetSize(width, height int) {
 	l.width = width
@@ -82,6 +94,7 @@ func (l *LinearLayout) Draw(screen *Screen, x, y, availableWidth, availableHeigh
 
 // drawWithBounds draws the layout with specific bounds
 func (l *LinearLayout) drawWithBounds(screen *Screen, x, y, width, height int, theme *Theme) {
+	l.err = nil
 	if len(l.items) == 0 {
 		return
 	}
@@ -96,18 +109,27 @@ func (l *LinearLayout) drawWithBounds(screen *Screen, x, y, width, height int, t
 	constraints := make([]ConstraintSet, len(l.items))
 	for i, item := range l.items {
 		constraints[i] = item.Constraint
+		if item.Constraint.Base.Type == Auto {
+			measuredWidth, measuredHeight := MeasureComponent(item.Component, contentRect.Width, contentRect.Height)
+			if l.config.Direction == Horizontal {
+				constraints[i] = item.Constraint.resolveAuto(measuredWidth)
+			} else {
+				constraints[i] = item.Constraint.resolveAuto(measuredHeight)
+			}
+		}
 	}
 
-	var sizes []int
+	var solution ConstraintSolution
 	if l.config.Direction == Horizontal {
 		// Account for spacing
 		availableSize := contentRect.Width - (len(l.items)-1)*l.config.Spacing
-		sizes = CalculateConstraints(constraints, availableSize)
+		solution, l.err = SolveConstraints(constraints, availableSize)
 	} else {
 		// Account for spacing
 		availableSize := contentRect.Height - (len(l.items)-1)*l.config.Spacing
-		sizes = CalculateConstraints(constraints, availableSize)
+		solution, l.err = SolveConstraints(constraints, availableSize)
 	}
+	sizes := solution.Sizes
 
 	// Draw items
 	currentX := contentRect.X
@@ -155,8 +177,47 @@ func (l *LinearLayout) drawWithBounds(screen *Screen, x, y, width, height int, t
 		}
 
 		// Draw the component
-		item.Component.Draw(screen, itemX, itemY, itemWidth, itemHeight, theme)
+		screen.DrawConstrainedComponent(item.Component, item.Constraint.String(), itemX, itemY, itemWidth, itemHeight, theme)
+	}
+}
+
+// Measure returns the size needed to fit all items at their preferred sizes
+func (l *LinearLayout) Measure(maxWidth, maxHeight int) (width, height int) {
+	padding := l.config.Padding
+	innerWidth := maxWidth - padding.Left - padding.Right
+	innerHeight := maxHeight - padding.Top - padding.Bottom
+
+	mainSize, crossSize := 0, 0
+	for i, item := range l.items {
+		itemWidth, itemHeight := MeasureComponent(item.Component, innerWidth, innerHeight)
+
+		// Fixed lengths along the layout direction win over measurement
+		if item.Constraint.Base.Type == Length {
+			if l.config.Direction == Horizontal {
+				itemWidth = int(item.Constraint.Base.Value)
+			} else {
+				itemHeight = int(item.Constraint.Base.Value)
+			}
+		}
+
+		if i > 0 {
+			mainSize += l.config.Spacing
+		}
+		if l.config.Direction == Horizontal {
+			mainSize += itemWidth
+			crossSize = max(crossSize, itemHeight)
+		} else {
+			mainSize += itemHeight
+			crossSize = max(crossSize, itemWidth)
+		}
 	}
+
+	if l.config.Direction == Horizontal {
+		width, height = mainSize, crossSize
+	} else {
+		width, height = crossSize, mainSize
+	}
+	return width + padding.Left + padding.Right, height + padding.Top + padding.Bottom
 }
 
 // Clear removes all items from the layout
@@ -187,48 +248,40 @@ func (l *LinearLayout) GetItem(index int) Component {
 // Focus gives keyboard focus to this component
 func (l *LinearLayout) Focus() {
 	// Focus first focusable item
-	for _, item := range l.items {
-		if focusable, ok := item.Component.(Focusable); ok {
-			focusable.Focus()
-			break
-		}
-	}
+	focusFirst(l.Children())
 }
 
 // Blur removes keyboard focus from this component
 func (l *LinearLayout) Blur() {
 	// Blur all items
-	for _, item := range l.items {
-		if focusable, ok := item.Component.(Focusable); ok {
-			focusable.Blur()
-		}
-	}
+	blurAll(l.Children())
 }
 
 // IsFocused returns whether this component currently has focus
 func (l *LinearLayout) IsFocused() bool {
 	// Check if any item is focused
-	for _, item := range l.items {
-		if focusable, ok := item.Component.(Focusable); ok {
-			if focusable.IsFocused() {
-				return true
-			}
-		}
-	}
-	return false
+	return anyFocused(l.Children())
 }
 
 // HandleKey processes keyboard input when focused
 func (l *LinearLayout) HandleKey(key string) bool {
 	// Pass to focused item
-	for _, item := range l.items {
-		if handler, ok := item.Component.(interface{ HandleKey(string) bool }); ok {
-			if focusable, ok := item.Component.(Focusable); ok && focusable.IsFocused() {
-				return handler.HandleKey(key)
-			}
-		}
+	return handleKeyFocused(l.Children(), key)
+}
+
+// ForwardsFocus reports that the layout only passes focus on to its
+// children
+func (l *LinearLayout) ForwardsFocus() bool {
+	return true
+}
+
+// Children returns the components in the layout
+func (l *LinearLayout) Children() []Component {
+	children := make([]Component, len(l.items))
+	for i, item := range l.items {
+		children[i] = item.Component
 	}
-	return false
+	return children
 }
 
 // HBox creates a horizontal linear layout
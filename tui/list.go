@@ -0,0 +1,639 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ListItem is an entry in a List
+type ListItem struct {
+	Title    string
+	Detail   string // Secondary text shown on the right
+	Value    any    // Data for the caller; not used by the list
+	Disabled bool   // Shown but can't be moved to or selected
+	Header   bool   // Section header above the items that follow it
+}
+
+// ListItemState is the state a list item is drawn in
+type ListItemState struct {
+	Hovered  bool  // The cursor is on the item and the list has focus
+	Selected bool  // The item is selected
+	Disabled bool  // The item can't be selected
+	Multi    bool  // The list allows selecting several items
+	Matches  []int // Rune indexes of the title matched by the filter
+}
+
+// Colors returns the theme's interactive colors for the state
+// Disabled wins over hovered, and hovered over selected.
+func (s ListItemState) Colors(theme *Theme) StateColors {
+	interactive := theme.Components.Interactive
+	switch {
+	case s.Disabled:
+		return interactive.Disabled
+	case s.Hovered:
+		return interactive.Hover
+	case s.Selected:
+		return interactive.Selected
+	}
+	return interactive.Normal
+}
+
+// Style returns a text style with the state's colors
+func (s ListItemState) Style(theme *Theme) lipgloss.Style {
+	colors := s.Colors(theme)
+	return lipgloss.NewStyle().Foreground(colors.Text).Background(colors.Background)
+}
+
+// ItemRenderer draws a List item on one row
+type ItemRenderer interface {
+	RenderItem(screen *Screen, item ListItem, state ListItemState, x, y, width int, theme *Theme)
+}
+
+// ItemRendererFunc adapts a function to an ItemRenderer
+type ItemRendererFunc func(screen *Screen, item ListItem, state ListItemState, x, y, width int, theme *Theme)
+
+// RenderItem calls f
+func (f ItemRendererFunc) RenderItem(screen *Screen, item ListItem, state ListItemState, x, y, width int, theme *Theme) {
+	f(screen, item, state, x, y, width, theme)
+}
+
+// DefaultItemRenderer draws an item as a marker, its title with filter
+// matches highlighted, and its detail right-aligned
+type DefaultItemRenderer struct{}
+
+// RenderItem draws an item or a section header
+func (DefaultItemRenderer) RenderItem(screen *Screen, item ListItem, state ListItemState, x, y, width int, theme *Theme) {
+	if item.Header {
+		headerStyle := lipgloss.NewStyle().
+			Foreground(theme.Palette.TextMuted).
+			Background(theme.Components.Interactive.Normal.Background).
+			Bold(true)
+		screen.DrawString(x, y, FillRight(Truncate(item.Title, width), width), headerStyle)
+		return
+	}
+
+	style := state.Style(theme)
+	screen.DrawString(x, y, strings.Repeat(" ", width), style)
+
+	marker := "  "
+	switch {
+	case state.Multi && state.Selected:
+		marker = "[x] "
+	case state.Multi:
+		marker = "[ ] "
+	case state.Selected:
+		marker = "▶ "
+	}
+
+	detailWidth := 0
+	if item.Detail != "" {
+		detailWidth = StringWidth(item.Detail) + 1
+	}
+	col := x
+	for _, r := range marker {
+		screen.DrawRune(col, y, r, style)
+		col += RuneWidth(r)
+	}
+	limit := x + width - detailWidth
+
+	matched := map[int]bool{}
+	for _, position := range state.Matches {
+		matched[position] = true
+	}
+	matchStyle := style.Foreground(theme.Palette.Gold).Bold(true)
+	for i, r := range []rune(item.Title) {
+		w := RuneWidth(r)
+		if col+w > limit {
+			break
+		}
+		if matched[i] {
+			screen.DrawRune(col, y, r, matchStyle)
+		} else {
+			screen.DrawRune(col, y, r, style)
+		}
+		col += w
+	}
+
+	if detailWidth > 0 && detailWidth < width {
+		detailStyle := style
+		if !state.Hovered && !state.Selected && !state.Disabled {
+			detailStyle = style.Foreground(theme.Palette.TextMuted)
+		}
+		screen.DrawString(x+width-detailWidth+1, y, item.Detail, detailStyle)
+	}
+}
+
+// List is a scrollable list of items with single or multiple selection
+//
+// The cursor moves over items, skipping headers and disabled items, and the
+// view scrolls to keep it visible. In a filterable list typing narrows the
+// items with fuzzy matching; headers stay above their matching items.
+// Indexes always refer to the full item list, whatever the filter shows.
+type List struct {
+	items      []ListItem
+	selected   map[int]bool
+	cursor     int // Index into items, or -1 when nothing can be selected
+	offset     int // First visible row
+	height     int // Rows shown in the last Draw
+	multi      bool
+	filterable bool
+	filter     string
+	rows       []int // Indexes of the items shown, in order
+	matches    map[int][]int
+	renderer   ItemRenderer
+	keymap     *Keymap
+	focused    bool
+	onChange   func(selected []int)
+	onHover    func(index int)
+}
+
+// NewList creates an empty single-selection list
+func NewList() *List {
+	l := &List{
+		selected: map[int]bool{},
+		cursor:   -1,
+		renderer: DefaultItemRenderer{},
+		keymap:   DefaultListKeymap(),
+	}
+	l.refilter()
+	return l
+}
+
+// DefaultListKeymap returns the default key bindings of a List
+func DefaultListKeymap() *Keymap {
+	return NewKeymap("list",
+		KeyBinding{Action: "up", Keys: []string{"up", "k"}, Help: "up"},
+		KeyBinding{Action: "down", Keys: []string{"down", "j"}, Help: "down"},
+		KeyBinding{Action: "page-up", Keys: []string{"pgup"}, Help: "page up"},
+		KeyBinding{Action: "page-down", Keys: []string{"pgdown"}, Help: "page down"},
+		KeyBinding{Action: "top", Keys: []string{"home", "g"}, Help: "first"},
+		KeyBinding{Action: "bottom", Keys: []string{"end", "G"}, Help: "last"},
+		KeyBinding{Action: "select", Keys: []string{"enter", " "}, Help: "select"},
+		KeyBinding{Action: "select-all", Keys: []string{"ctrl+a"}, Help: "select all"},
+		KeyBinding{Action: "clear-filter", Keys: []string{"esc"}, Help: "clear filter"},
+	)
+}
+
+// Keymap returns the list's key bindings
+func (l *List) Keymap() *Keymap {
+	return l.keymap
+}
+
+// SetKeymap sets the list's key bindings
+func (l *List) SetKeymap(keymap *Keymap) {
+	l.keymap = keymap
+}
+
+// SetItems replaces all items and clears the selection
+func (l *List) SetItems(items []ListItem) {
+	l.items = append([]ListItem(nil), items...)
+	l.selected = map[int]bool{}
+	l.cursor = -1
+	l.offset = 0
+	l.refilter()
+}
+
+// AddItem appends an item
+func (l *List) AddItem(item ListItem) {
+	l.items = append(l.items, item)
+	l.refilter()
+}
+
+// AddHeader appends a section header
+func (l *List) AddHeader(title string) {
+	l.AddItem(ListItem{Title: title, Header: true})
+}
+
+// Items returns all items
+func (l *List) Items() []ListItem {
+	return append([]ListItem(nil), l.items...)
+}
+
+// Item returns the item at an index
+func (l *List) Item(index int) (ListItem, bool) {
+	if index < 0 || index >= len(l.items) {
+		return ListItem{}, false
+	}
+	return l.items[index], true
+}
+
+// SetMultiSelect sets whether several items can be selected at once
+// Switching to single selection keeps only the first selected item.
+func (l *List) SetMultiSelect(multi bool) {
+	l.multi = multi
+	if selected := l.Selected(); !multi && len(selected) > 1 {
+		l.selected = map[int]bool{selected[0]: true}
+	}
+}
+
+// SetFilterable sets whether typing filters the items
+// Printable keys then go to the filter instead of the keymap, so bindings
+// like "j" and "k" only work while it's off.
+func (l *List) SetFilterable(filterable bool) {
+	l.filterable = filterable
+	if !filterable {
+		l.SetFilter("")
+	}
+}
+
+// SetRenderer sets how items are drawn
+func (l *List) SetRenderer(renderer ItemRenderer) {
+	l.renderer = renderer
+}
+
+// OnChange sets the function called when the selection changes
+func (l *List) OnChange(handler func(selected []int)) {
+	l.onChange = handler
+}
+
+// OnHover sets the function called when the cursor moves to another item
+func (l *List) OnHover(handler func(index int)) {
+	l.onHover = handler
+}
+
+// Filter returns the filter text
+func (l *List) Filter() string {
+	return l.filter
+}
+
+// SetFilter sets the filter text and moves the cursor to the first match
+// if its item was filtered out
+func (l *List) SetFilter(filter string) {
+	l.filter = filter
+	l.refilter()
+}
+
+// Visible returns the indexes of the items the filter shows, headers
+// included
+func (l *List) Visible() []int {
+	return append([]int(nil), l.rows...)
+}
+
+// Cursor returns the index of the item under the cursor, or -1
+func (l *List) Cursor() int {
+	return l.cursor
+}
+
+// SetCursor moves the cursor to an item
+// Headers, disabled items and filtered out items are skipped forward.
+func (l *List) SetCursor(index int) {
+	for row, item := range l.rows {
+		if item >= index && l.selectable(item) {
+			l.moveTo(row)
+			return
+		}
+	}
+}
+
+// Selected returns the indexes of the selected items in order
+func (l *List) Selected() []int {
+	var selected []int
+	for i := range l.items {
+		if l.selected[i] {
+			selected = append(selected, i)
+		}
+	}
+	return selected
+}
+
+// SelectedItem returns the first selected item and its index
+func (l *List) SelectedItem() (ListItem, int, bool) {
+	if selected := l.Selected(); len(selected) > 0 {
+		return l.items[selected[0]], selected[0], true
+	}
+	return ListItem{}, -1, false
+}
+
+// IsSelected returns whether an item is selected
+func (l *List) IsSelected(index int) bool {
+	return l.selected[index]
+}
+
+// Select selects an item, replacing the selection unless the list is
+// multi-select
+// Headers and disabled items can't be selected.
+func (l *List) Select(index int) {
+	if index < 0 || index >= len(l.items) || l.items[index].Header || l.items[index].Disabled {
+		return
+	}
+	if l.selected[index] && (l.multi || len(l.selected) == 1) {
+		return
+	}
+	if !l.multi {
+		l.selected = map[int]bool{}
+	}
+	l.selected[index] = true
+	l.changed()
+}
+
+// Deselect removes an item from the selection
+func (l *List) Deselect(index int) {
+	if l.selected[index] {
+		delete(l.selected, index)
+		l.changed()
+	}
+}
+
+// Toggle selects an item, or deselects it if it's selected in a
+// multi-select list
+func (l *List) Toggle(index int) {
+	if l.multi && l.selected[index] {
+		l.Deselect(index)
+	} else {
+		l.Select(index)
+	}
+}
+
+// SelectAll selects every visible selectable item of a multi-select list
+func (l *List) SelectAll() {
+	if !l.multi {
+		return
+	}
+	changed := false
+	for _, i := range l.rows {
+		if l.selectable(i) && !l.selected[i] {
+			l.selected[i] = true
+			changed = true
+		}
+	}
+	if changed {
+		l.changed()
+	}
+}
+
+// ClearSelection deselects every item
+func (l *List) ClearSelection() {
+	if len(l.selected) > 0 {
+		l.selected = map[int]bool{}
+		l.changed()
+	}
+}
+
+// changed notifies the OnChange handler
+func (l *List) changed() {
+	if l.onChange != nil {
+		l.onChange(l.Selected())
+	}
+}
+
+// selectable returns whether the cursor can rest on an item
+func (l *List) selectable(index int) bool {
+	return !l.items[index].Header && !l.items[index].Disabled
+}
+
+// refilter rebuilds the visible rows from the filter
+func (l *List) refilter() {
+	l.rows = l.rows[:0]
+	l.matches = map[int][]int{}
+	header := -1
+	for i, item := range l.items {
+		if item.Header {
+			header = i
+			if l.filter == "" {
+				l.rows = append(l.rows, i)
+			}
+			continue
+		}
+		if l.filter != "" {
+			match, ok := FuzzyScore(l.filter, item.Title)
+			if !ok {
+				continue
+			}
+			l.matches[i] = match.Positions
+			// Show the section header above its first match
+			if header >= 0 {
+				l.rows = append(l.rows, header)
+				header = -1
+			}
+		}
+		l.rows = append(l.rows, i)
+	}
+
+	if row := l.rowOf(l.cursor); row >= 0 && l.selectable(l.cursor) {
+		return
+	}
+	l.cursor = -1
+	l.offset = 0
+	for row, item := range l.rows {
+		if l.selectable(item) {
+			l.moveTo(row)
+			return
+		}
+	}
+}
+
+// rowOf returns the visible row of an item, or -1
+func (l *List) rowOf(index int) int {
+	for row, item := range l.rows {
+		if item == index {
+			return row
+		}
+	}
+	return -1
+}
+
+// moveTo puts the cursor on the item of a visible row
+func (l *List) moveTo(row int) {
+	index := l.rows[row]
+	if index == l.cursor {
+		return
+	}
+	l.cursor = index
+	if l.onHover != nil {
+		l.onHover(index)
+	}
+}
+
+// move moves the cursor by delta selectable items, stopping at the ends
+func (l *List) move(delta int) {
+	row := l.rowOf(l.cursor)
+	if row < 0 {
+		return
+	}
+	direction := 1
+	if delta < 0 {
+		direction, delta = -1, -delta
+	}
+	target := row
+	for r := row + direction; r >= 0 && r < len(l.rows) && delta > 0; r += direction {
+		if l.selectable(l.rows[r]) {
+			target = r
+			delta--
+		}
+	}
+	l.moveTo(target)
+}
+
+// MoveUp moves the cursor to the previous item
+func (l *List) MoveUp() {
+	l.move(-1)
+}
+
+// MoveDown moves the cursor to the next item
+func (l *List) MoveDown() {
+	l.move(1)
+}
+
+// pageSize returns how many rows a page scrolls
+func (l *List) pageSize() int {
+	return max(1, l.height-1)
+}
+
+// HandleKey processes keyboard input
+// Returns true if the key was handled
+func (l *List) HandleKey(key string) bool {
+	if l.filterable && key != " " && len([]rune(key)) == 1 && key[0] >= 32 {
+		l.SetFilter(l.filter + key)
+		return true
+	}
+	if l.filterable && l.filter != "" && (key == "backspace" || key == "ctrl+h") {
+		runes := []rune(l.filter)
+		l.SetFilter(string(runes[:len(runes)-1]))
+		return true
+	}
+
+	switch l.keymap.Action(key) {
+	case "up":
+		l.move(-1)
+	case "down":
+		l.move(1)
+	case "page-up":
+		l.move(-l.pageSize())
+	case "page-down":
+		l.move(l.pageSize())
+	case "top":
+		l.move(-len(l.rows))
+	case "bottom":
+		l.move(len(l.rows))
+	case "select":
+		if l.cursor < 0 {
+			return false
+		}
+		l.Toggle(l.cursor)
+	case "select-all":
+		if !l.multi {
+			return false
+		}
+		l.SelectAll()
+	case "clear-filter":
+		if l.filter == "" {
+			return false
+		}
+		l.SetFilter("")
+	default:
+		return false
+	}
+	return true
+}
+
+// HandleInput processes keyboard input
+func (l *List) HandleInput(key string) {
+	l.HandleKey(key)
+}
+
+// Focus gives keyboard focus to this component
+func (l *List) Focus() {
+	l.focused = true
+}
+
+// Blur removes keyboard focus from this component
+func (l *List) Blur() {
+	l.focused = false
+}
+
+// IsFocused returns whether this component currently has focus
+func (l *List) IsFocused() bool {
+	return l.focused
+}
+
+// Measure returns the width of the widest item and one row per visible
+// item, plus a row for the filter while one is set
+func (l *List) Measure(maxWidth, maxHeight int) (width, height int) {
+	for _, i := range l.rows {
+		item := l.items[i]
+		w := StringWidth(item.Title)
+		if !item.Header {
+			w += 4 // Marker
+		}
+		if item.Detail != "" {
+			w += StringWidth(item.Detail) + 1
+		}
+		width = max(width, w)
+	}
+	height = max(1, len(l.rows))
+	if l.filter != "" {
+		height++
+	}
+	return min(width, maxWidth), min(height, maxHeight)
+}
+
+// Draw renders the visible items, a scrollbar when they overflow and the
+// filter text
+func (l *List) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	width, height := availableWidth, availableHeight
+	if width <= 0 || height <= 0 {
+		return
+	}
+	ClearArea(screen, x, y, width, height, lipgloss.NewStyle().Background(theme.Components.Interactive.Normal.Background))
+
+	if l.filter != "" && height > 1 {
+		height--
+		filterStyle := lipgloss.NewStyle().
+			Foreground(theme.Palette.TextMuted).
+			Background(theme.Components.Interactive.Normal.Background)
+		screen.DrawString(x, y+height, Truncate("/"+l.filter, width), filterStyle)
+	}
+	l.height = height
+
+	if len(l.rows) == 0 {
+		emptyStyle := lipgloss.NewStyle().
+			Foreground(theme.Palette.TextMuted).
+			Background(theme.Components.Interactive.Normal.Background).
+			Italic(true)
+		screen.DrawString(x, y, Truncate("No items", width), emptyStyle)
+		return
+	}
+
+	l.scrollIntoView()
+	scrollbar := len(l.rows) > height && width > 1
+	if scrollbar {
+		width--
+	}
+
+	for i := 0; i < height && l.offset+i < len(l.rows); i++ {
+		index := l.rows[l.offset+i]
+		item := l.items[index]
+		state := ListItemState{
+			Hovered:  l.focused && index == l.cursor,
+			Selected: l.selected[index],
+			Disabled: item.Disabled,
+			Multi:    l.multi,
+			Matches:  l.matches[index],
+		}
+		l.renderer.RenderItem(screen, item, state, x, y+i, width, theme)
+	}
+
+	if scrollbar {
+		drawVerticalScrollbar(screen, x+width, y, height, len(l.rows), l.offset, l.focused, theme)
+	}
+}
+
+// scrollIntoView scrolls the least needed to show the cursor, along with
+// its section header when that fits
+func (l *List) scrollIntoView() {
+	l.offset = max(0, min(l.offset, len(l.rows)-l.height))
+	row := l.rowOf(l.cursor)
+	if row < 0 {
+		return
+	}
+	top := row
+	if top > 0 && l.items[l.rows[top-1]].Header {
+		top--
+	}
+	if top < l.offset {
+		l.offset = top
+	} else if row >= l.offset+l.height {
+		l.offset = row - l.height + 1
+	}
+}
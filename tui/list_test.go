@@ -0,0 +1,177 @@
+package tui
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newTestList() *List {
+	list := NewList()
+	list.SetItems([]ListItem{
+		{Title: "Fruit", Header: true},
+		{Title: "Apple"},
+		{Title: "Banana", Disabled: true},
+		{Title: "Cherry"},
+		{Title: "Vegetables", Header: true},
+		{Title: "Carrot", Detail: "orange"},
+		{Title: "Leek"},
+	})
+	list.Focus()
+	return list
+}
+
+func TestListCursorSkipsHeadersAndDisabled(t *testing.T) {
+	list := newTestList()
+	if list.Cursor() != 1 {
+		t.Fatalf("Expected the cursor on the first item, got %d", list.Cursor())
+	}
+
+	list.HandleKey("down")
+	if list.Cursor() != 3 {
+		t.Errorf("Expected the disabled item to be skipped, got %d", list.Cursor())
+	}
+	list.HandleKey("down")
+	if list.Cursor() != 5 {
+		t.Errorf("Expected the header to be skipped, got %d", list.Cursor())
+	}
+	list.HandleKey("end")
+	list.HandleKey("down") // Stays on the last item
+	if list.Cursor() != 6 {
+		t.Errorf("Expected the cursor on the last item, got %d", list.Cursor())
+	}
+	list.HandleKey("home")
+	if list.Cursor() != 1 {
+		t.Errorf("Expected the cursor back on the first item, got %d", list.Cursor())
+	}
+}
+
+func TestListSingleSelection(t *testing.T) {
+	var changes [][]int
+	list := newTestList()
+	list.OnChange(func(selected []int) { changes = append(changes, selected) })
+
+	list.HandleKey("enter")
+	list.HandleKey("down")
+	list.HandleKey(" ")
+	list.HandleKey(" ") // Already selected
+
+	if want := [][]int{{1}, {3}}; !reflect.DeepEqual(changes, want) {
+		t.Errorf("Expected changes %v, got %v", want, changes)
+	}
+	if item, index, ok := list.SelectedItem(); !ok || index != 3 || item.Title != "Cherry" {
+		t.Errorf("Expected Cherry selected, got %q at %d", item.Title, index)
+	}
+
+	list.Select(2) // Disabled
+	list.Select(0) // Header
+	if !reflect.DeepEqual(list.Selected(), []int{3}) {
+		t.Errorf("Expected disabled items and headers not to be selectable, got %v", list.Selected())
+	}
+}
+
+func TestListMultiSelection(t *testing.T) {
+	list := newTestList()
+	list.SetMultiSelect(true)
+
+	list.HandleKey(" ")
+	list.HandleKey("down")
+	list.HandleKey(" ")
+	if !reflect.DeepEqual(list.Selected(), []int{1, 3}) {
+		t.Errorf("Expected two items selected, got %v", list.Selected())
+	}
+	list.HandleKey(" ") // Toggles off
+	if !reflect.DeepEqual(list.Selected(), []int{1}) {
+		t.Errorf("Expected Cherry deselected, got %v", list.Selected())
+	}
+
+	list.HandleKey("ctrl+a")
+	if !reflect.DeepEqual(list.Selected(), []int{1, 3, 5, 6}) {
+		t.Errorf("Expected every selectable item, got %v", list.Selected())
+	}
+
+	list.SetMultiSelect(false)
+	if !reflect.DeepEqual(list.Selected(), []int{1}) {
+		t.Errorf("Expected single selection to keep the first item, got %v", list.Selected())
+	}
+}
+
+func TestListTypeToFilter(t *testing.T) {
+	list := newTestList()
+	list.SetFilterable(true)
+
+	list.HandleKey("c")
+	list.HandleKey("r")
+	// Cherry and Carrot match, each with its section header
+	if want := []int{0, 3, 4, 5}; !reflect.DeepEqual(list.Visible(), want) {
+		t.Errorf("Expected visible %v, got %v", want, list.Visible())
+	}
+	if list.Cursor() != 3 {
+		t.Errorf("Expected the cursor on the first match, got %d", list.Cursor())
+	}
+
+	list.HandleKey("j") // Typed, not a navigation key
+	if list.Filter() != "crj" || len(list.Visible()) != 0 {
+		t.Errorf("Expected no matches for crj, got %v", list.Visible())
+	}
+	list.HandleKey("backspace")
+	list.HandleKey("esc")
+	if list.Filter() != "" || len(list.Visible()) != 7 {
+		t.Errorf("Expected esc to clear the filter, got %q", list.Filter())
+	}
+	if list.HandleKey("esc") {
+		t.Error("Expected esc to go unhandled without a filter")
+	}
+}
+
+func TestListScrollsCursorIntoView(t *testing.T) {
+	list := NewList()
+	for _, title := range []string{"one", "two", "three", "four", "five", "six"} {
+		list.AddItem(ListItem{Title: title})
+	}
+	list.Focus()
+
+	sim := NewScreenSimulation(20, 3)
+	theme := NewTestTheme()
+	list.Draw(sim.Screen, 0, 0, 20, 3, theme)
+	AssertTextExists(t, sim, "one")
+	AssertTextNotExists(t, sim, "four")
+
+	list.HandleKey("down")
+	list.HandleKey("down")
+	list.HandleKey("down")
+	sim = NewScreenSimulation(20, 3)
+	list.Draw(sim.Screen, 0, 0, 20, 3, theme)
+	AssertTextExists(t, sim, "four")
+	AssertTextNotExists(t, sim, "one")
+
+	list.HandleKey("end")
+	sim = NewScreenSimulation(20, 3)
+	list.Draw(sim.Screen, 0, 0, 20, 3, theme)
+	AssertTextExists(t, sim, "six")
+	AssertCellRune(t, sim, 19, 2, '█')
+}
+
+func TestListItemRenderer(t *testing.T) {
+	var states []ListItemState
+	list := newTestList()
+	list.Select(3)
+	list.SetRenderer(ItemRendererFunc(func(screen *Screen, item ListItem, state ListItemState, x, y, width int, theme *Theme) {
+		states = append(states, state)
+		screen.DrawString(x, y, "* "+item.Title, state.Style(theme))
+	}))
+
+	sim := NewScreenSimulation(20, 10)
+	theme := NewTestTheme()
+	list.Draw(sim.Screen, 0, 0, 20, 10, theme)
+	AssertTextExists(t, sim, "* Carrot")
+
+	if !states[1].Hovered || states[1].Selected {
+		t.Errorf("Expected Apple hovered, got %+v", states[1])
+	}
+	if !states[2].Disabled || !states[3].Selected {
+		t.Errorf("Expected Banana disabled and Cherry selected, got %+v %+v", states[2], states[3])
+	}
+	if colors := states[2].Colors(theme); colors != theme.Components.Interactive.Disabled {
+		t.Error("Expected disabled items to use the theme's disabled colors")
+	}
+}
@@ -0,0 +1,124 @@
+package tui
+
+import (
+	"testing"
+)
+
+func TestMeasureComponentFallback(t *testing.T) {
+	// Components without Measure take all the offered space
+	width, height := MeasureComponent(&mockComponent{}, 30, 10)
+	if width != 30 || height != 10 {
+		t.Errorf("Expected 30x10, got %dx%d", width, height)
+	}
+}
+
+func TestMeasureComponentClamps(t *testing.T) {
+	input := NewInput()
+	input.SetValue("a fairly long value")
+
+	width, height := MeasureComponent(input, 5, 10)
+	if width != 5 || height != 1 {
+		t.Errorf("Expected 5x1, got %dx%d", width, height)
+	}
+}
+
+func TestBuiltinMeasure(t *testing.T) {
+	t.Run("Input", func(t *testing.T) {
+		input := NewInput()
+		input.SetPlaceholder("Search...")
+		if w, h := input.Measure(80, 24); w != 9 || h != 1 {
+			t.Errorf("Expected 9x1, got %dx%d", w, h)
+		}
+		input.SetValue("hello world")
+		if w, _ := input.Measure(80, 24); w != 12 {
+			t.Errorf("Expected width 12, got %d", w)
+		}
+	})
+
+	t.Run("StatusBar", func(t *testing.T) {
+		sb := NewStatusBar()
+		sb.AddSegment("NORMAL", "left")
+		sb.AddSegment("main.go", "left")
+		if w, h := sb.Measure(80, 24); w != 16 || h != 1 {
+			t.Errorf("Expected 16x1, got %dx%d", w, h)
+		}
+	})
+
+	t.Run("Viewer wraps to width", func(t *testing.T) {
+		viewer := NewViewer()
+		viewer.SetContent("one two three four\nfive")
+		if w, h := viewer.Measure(80, 24); w != 18 || h != 2 {
+			t.Errorf("Expected 18x2, got %dx%d", w, h)
+		}
+		if _, h := viewer.Measure(9, 24); h < 3 {
+			t.Errorf("Expected wrapped height of at least 3, got %d", h)
+		}
+	})
+
+	t.Run("TextElement", func(t *testing.T) {
+		text := NewTextElement("Title")
+		if w, h := text.Measure(80, 24); w != 7 || h != 1 {
+			t.Errorf("Expected 7x1, got %dx%d", w, h)
+		}
+	})
+
+	t.Run("Container adds border and padding", func(t *testing.T) {
+		input := NewInput()
+		input.SetValue("abc")
+		container := NewContainer()
+		container.SetContent(input)
+		if w, h := container.Measure(80, 24); w != 8 || h != 5 {
+			t.Errorf("Expected 8x5, got %dx%d", w, h)
+		}
+	})
+
+	t.Run("VBox sums heights", func(t *testing.T) {
+		layout := VBox()
+		layout.SetSpacing(1)
+		layout.AddAuto(NewStatusBar())
+		layout.AddFixed(&mockComponent{}, 3)
+		if _, h := layout.Measure(80, 24); h != 5 {
+			t.Errorf("Expected height 5, got %d", h)
+		}
+	})
+}
+
+func TestLinearLayoutAutoConstraint(t *testing.T) {
+	screen := NewScreenSimulation(40, 20)
+	theme := NewTestTheme()
+
+	header := NewContainer()
+	header.SetPadding(NewMargin(0))
+	header.SetContent(NewStatusBar())
+	body := &mockComponent{}
+	footer := NewStatusBar()
+
+	layout := VBox()
+	layout.AddAuto(header)
+	layout.AddFlex(body, 1)
+	layout.AddAuto(footer)
+	layout.Draw(screen.Screen, 0, 0, 40, 20, theme)
+
+	// Header is 3 rows (border + 1 line), footer is 1 row
+	if body.height != 16 {
+		t.Errorf("Expected body height 16, got %d", body.height)
+	}
+}
+
+func TestStackAutoConstraint(t *testing.T) {
+	screen := NewScreenSimulation(40, 20)
+	theme := NewTestTheme()
+
+	input := NewInput()
+	input.SetValue("centered")
+	container := NewContainer()
+	container.SetContent(input)
+
+	stack := NewStack()
+	stack.AddAutoCentered(container)
+	stack.Draw(screen.Screen, 0, 0, 40, 20, theme)
+
+	// Container is 13x5: input width 9, plus border and padding
+	AssertCellRune(t, screen, 14, 8, '┌')
+	AssertCellRune(t, screen, 26, 12, '┘')
+}
@@ -0,0 +1,673 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// MenuItem is an entry in a Menu
+//
+// Choosing an item toggles it when Checkable, then calls OnSelect and the
+// owning MenuBar or ContextMenu's OnSelect. Items with a Submenu open it
+// instead. The hint on the right is Hint, or else the keys bound to Action
+// in the accelerator keymaps.
+type MenuItem struct {
+	Label     string
+	Action    string // Name passed on to OnSelect and looked up for the hint
+	Hint      string // Shown instead of the accelerator keys when set
+	Disabled  bool
+	Checkable bool
+	Checked   bool
+	Separator bool
+	Submenu   *Menu
+	OnSelect  func()
+}
+
+// selectable returns whether the cursor can rest on the item
+func (i *MenuItem) selectable() bool {
+	return !i.Separator && !i.Disabled
+}
+
+// Menu is a titled list of items, shown as a dropdown from a MenuBar, as a
+// ContextMenu or as a submenu
+type Menu struct {
+	title string
+	items []*MenuItem
+}
+
+// NewMenu creates an empty menu
+func NewMenu(title string) *Menu {
+	return &Menu{title: title}
+}
+
+// Title returns the name shown in a menu bar or on the parent item
+func (m *Menu) Title() string {
+	return m.title
+}
+
+// SetTitle sets the name shown in a menu bar
+func (m *Menu) SetTitle(title string) {
+	m.title = title
+}
+
+// Items returns the menu's items
+func (m *Menu) Items() []*MenuItem {
+	return m.items
+}
+
+// Add appends a copy of an item and returns it, for changing later
+func (m *Menu) Add(item MenuItem) *MenuItem {
+	m.items = append(m.items, &item)
+	return m.items[len(m.items)-1]
+}
+
+// AddItem appends an item that runs an action
+func (m *Menu) AddItem(label, action string) *MenuItem {
+	return m.Add(MenuItem{Label: label, Action: action})
+}
+
+// AddCheckItem appends an item that toggles on and off
+func (m *Menu) AddCheckItem(label, action string, checked bool) *MenuItem {
+	return m.Add(MenuItem{Label: label, Action: action, Checkable: true, Checked: checked})
+}
+
+// AddSubmenu appends an item that opens another menu
+func (m *Menu) AddSubmenu(label string, submenu *Menu) *MenuItem {
+	return m.Add(MenuItem{Label: label, Submenu: submenu})
+}
+
+// AddSeparator appends a dividing line
+func (m *Menu) AddSeparator() {
+	m.Add(MenuItem{Separator: true})
+}
+
+// Item returns the first item with an action, searching submenus too, or
+// nil
+func (m *Menu) Item(action string) *MenuItem {
+	for _, item := range m.items {
+		if item.Action == action && !item.Separator {
+			return item
+		}
+		if item.Submenu != nil {
+			if found := item.Submenu.Item(action); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
+// DefaultMenuKeymap returns the bindings for moving through open menus
+// In a menu bar, right and left move to the next and previous menu when
+// there's no submenu to open or close.
+func DefaultMenuKeymap() *Keymap {
+	return NewKeymap("menu",
+		KeyBinding{Action: "up", Keys: []string{"up", "k"}, Help: "previous item"},
+		KeyBinding{Action: "down", Keys: []string{"down", "j"}, Help: "next item"},
+		KeyBinding{Action: "first", Keys: []string{"home"}, Help: "first item"},
+		KeyBinding{Action: "last", Keys: []string{"end"}, Help: "last item"},
+		KeyBinding{Action: "open-submenu", Keys: []string{"right", "l"}, Help: "open submenu"},
+		KeyBinding{Action: "close-submenu", Keys: []string{"left", "h"}, Help: "close submenu"},
+		KeyBinding{Action: "accept", Keys: []string{"enter", " "}, Help: "choose"},
+		KeyBinding{Action: "close", Keys: []string{"esc"}, Help: "close"},
+	)
+}
+
+// DefaultMenuBarKeymap returns the bindings for opening a menu bar
+// Alt and a menu's first letter also opens that menu.
+func DefaultMenuBarKeymap() *Keymap {
+	return NewKeymap("menu-bar",
+		KeyBinding{Action: "activate", Keys: []string{"f10"}, Help: "menus"},
+	)
+}
+
+// menuLevel is an open menu and the item the cursor is on
+type menuLevel struct {
+	menu   *Menu
+	cursor int // -1 when nothing can be chosen
+}
+
+// item returns the item under the cursor, or nil
+func (l *menuLevel) item() *MenuItem {
+	if l.cursor < 0 || l.cursor >= len(l.menu.items) {
+		return nil
+	}
+	return l.menu.items[l.cursor]
+}
+
+// move steps the cursor to the next selectable item in a direction,
+// wrapping around
+func (l *menuLevel) move(delta int) {
+	n := len(l.menu.items)
+	for step := 1; step <= n; step++ {
+		i := ((l.cursor+delta*step)%n + n) % n
+		if l.menu.items[i].selectable() {
+			l.cursor = i
+			return
+		}
+	}
+}
+
+// menuStack is the chain of open menus behind a MenuBar or ContextMenu,
+// from the top-level menu to the deepest open submenu
+type menuStack struct {
+	levels       []*menuLevel
+	keymap       *Keymap
+	accelerators []*Keymap
+	onSelect     func(item *MenuItem)
+}
+
+// open shows a menu on top of the stack with the cursor on its first
+// selectable item
+func (s *menuStack) open(menu *Menu) {
+	level := &menuLevel{menu: menu, cursor: -1}
+	level.move(1)
+	s.levels = append(s.levels, level)
+}
+
+// close closes every open menu
+func (s *menuStack) close() {
+	s.levels = nil
+}
+
+// isOpen returns whether any menu is showing
+func (s *menuStack) isOpen() bool {
+	return len(s.levels) > 0
+}
+
+// top returns the deepest open menu
+func (s *menuStack) top() *menuLevel {
+	return s.levels[len(s.levels)-1]
+}
+
+// handleKey moves through the open menus, returning whether the key was
+// used
+// Opening a submenu on an item without one, or closing the top-level menu
+// with close-submenu, is left unused for a menu bar to move between menus.
+func (s *menuStack) handleKey(key string) bool {
+	level := s.top()
+	switch s.keymap.Action(key) {
+	case "up":
+		level.move(-1)
+	case "down":
+		level.move(1)
+	case "first":
+		level.cursor = -1
+		level.move(1)
+	case "last":
+		level.cursor = len(level.menu.items)
+		level.move(-1)
+	case "open-submenu":
+		item := level.item()
+		if item == nil || item.Submenu == nil {
+			return false
+		}
+		s.open(item.Submenu)
+	case "close-submenu":
+		if len(s.levels) == 1 {
+			return false
+		}
+		s.levels = s.levels[:len(s.levels)-1]
+	case "accept":
+		s.accept()
+	case "close":
+		s.levels = s.levels[:len(s.levels)-1]
+	default:
+		return false
+	}
+	return true
+}
+
+// accept chooses the item under the cursor, opening its submenu or closing
+// the menus and running it
+func (s *menuStack) accept() {
+	item := s.top().item()
+	if item == nil {
+		return
+	}
+	if item.Submenu != nil {
+		s.open(item.Submenu)
+		return
+	}
+	s.close()
+	if item.Checkable {
+		item.Checked = !item.Checked
+	}
+	if item.OnSelect != nil {
+		item.OnSelect()
+	}
+	if s.onSelect != nil {
+		s.onSelect(item)
+	}
+}
+
+// hint returns the text shown on the right of an item
+func (s *menuStack) hint(item *MenuItem) string {
+	if item.Hint != "" || item.Action == "" {
+		return item.Hint
+	}
+	for _, keymap := range s.accelerators {
+		if keys := keymap.Keys(item.Action); len(keys) > 0 {
+			return FormatAccelerator(keys[0])
+		}
+	}
+	return ""
+}
+
+// FormatAccelerator writes a key string the way menus show it, like
+// "Ctrl+S" for "ctrl+s" or "F10" for "f10"
+// Single characters on their own are left alone, since "p" and "P" are
+// different keys.
+func FormatAccelerator(key string) string {
+	if key == " " {
+		return "Space"
+	}
+	parts := strings.Split(key, "+")
+	if len(parts) == 1 && len([]rune(key)) == 1 {
+		return key
+	}
+	for i, part := range parts {
+		parts[i] = strings.ToUpper(part[:min(1, len(part))]) + part[min(1, len(part)):]
+	}
+	return strings.Join(parts, "+")
+}
+
+// menuColumns is the width of each column of a drawn menu
+type menuColumns struct {
+	check, label, hint, arrow int
+}
+
+// width returns the popup width, borders included
+func (c menuColumns) width() int {
+	width := 2 + 1 + c.check + c.label + c.arrow + 1
+	if c.hint > 0 {
+		width += 3 + c.hint
+	}
+	return width
+}
+
+// columns measures a menu's items
+func (s *menuStack) columns(menu *Menu) menuColumns {
+	var columns menuColumns
+	for _, item := range menu.items {
+		if item.Separator {
+			continue
+		}
+		columns.label = max(columns.label, StringWidth(item.Label))
+		columns.hint = max(columns.hint, StringWidth(s.hint(item)))
+		if item.Checkable {
+			columns.check = 2
+		}
+		if item.Submenu != nil {
+			columns.arrow = 2
+		}
+	}
+	return columns
+}
+
+// placeSubmenu positions a submenu beside its parent, lined up with the
+// item that opened it, or on the left when there's no room on the right
+func placeSubmenu(parent Rectangle, row, width, height int, bounds Rectangle) Rectangle {
+	width, height = min(width, bounds.Width), min(height, bounds.Height)
+	x := parent.X + parent.Width
+	if x+width > bounds.X+bounds.Width {
+		x = max(bounds.X, parent.X-width)
+	}
+	// The first item sits on the parent's row, below the top border
+	y := min(row-1, bounds.Y+bounds.Height-height)
+	return Rectangle{X: x, Y: max(y, bounds.Y), Width: width, Height: height}
+}
+
+// draw draws every open menu, the first placed next to the anchor and each
+// submenu beside the item that opened it
+func (s *menuStack) draw(screen *Screen, anchor Rectangle, theme *Theme) {
+	bounds := Rectangle{Width: screen.Width(), Height: screen.Height()}
+	var rect Rectangle
+	for i, level := range s.levels {
+		columns := s.columns(level.menu)
+		width, height := columns.width(), len(level.menu.items)+2
+		if i == 0 {
+			rect = PlacePopup(anchor, width, height, bounds)
+		} else {
+			rect = placeSubmenu(rect, rect.Y+1+s.levels[i-1].cursor, width, height, bounds)
+		}
+		if rect.Width < 3 || rect.Height < 3 {
+			return
+		}
+		s.drawMenu(screen, level, rect, columns, i == len(s.levels)-1, theme)
+	}
+}
+
+// drawMenu draws one menu with a shadow, highlighting the cursor's item
+func (s *menuStack) drawMenu(screen *Screen, level *menuLevel, rect Rectangle, columns menuColumns, active bool, theme *Theme) {
+	surface := lipgloss.NewStyle().Background(theme.Palette.Surface)
+	border := surface.Foreground(theme.Palette.Border)
+	shadowStyle := lipgloss.NewStyle().Background(theme.Palette.Shadow)
+	screen.DrawBlockShadow(rect.X, rect.Y, rect.Width, rect.Height, shadowStyle, 1, 1)
+	ClearArea(screen, rect.X, rect.Y, rect.Width, rect.Height, surface)
+	screen.DrawBox(rect.X, rect.Y, rect.Width, rect.Height, border)
+
+	innerX, innerWidth := rect.X+1, rect.Width-2
+	items := level.menu.items[:min(len(level.menu.items), rect.Height-2)]
+	for i, item := range items {
+		row := rect.Y + 1 + i
+		if item.Separator {
+			screen.DrawRune(rect.X, row, '├', border)
+			screen.DrawString(innerX, row, strings.Repeat("─", innerWidth), border)
+			screen.DrawRune(rect.X+rect.Width-1, row, '┤', border)
+			continue
+		}
+
+		style := surface.Foreground(theme.Palette.Text)
+		hintStyle := surface.Foreground(theme.Palette.TextMuted)
+		if item.Disabled {
+			style = surface.Foreground(theme.Palette.TextSubtle)
+			hintStyle = style
+		}
+		if i == level.cursor {
+			// Parent menus keep their highlight to show the path taken
+			style = style.Background(theme.Palette.Overlay).Foreground(theme.Components.Interactive.Hover.Text).Bold(active)
+			hintStyle = hintStyle.Background(theme.Palette.Overlay)
+			screen.DrawString(innerX, row, strings.Repeat(" ", innerWidth), style)
+		}
+
+		col := innerX + 1
+		if columns.check > 0 {
+			if item.Checkable && item.Checked {
+				screen.DrawString(col, row, "✓", style)
+			}
+			col += columns.check
+		}
+		right := innerX + innerWidth - 1
+		if columns.arrow > 0 {
+			right -= columns.arrow
+			if item.Submenu != nil {
+				screen.DrawString(right, row, " ▸", style)
+			}
+		}
+		if hint := s.hint(item); hint != "" {
+			hintX := right - StringWidth(hint)
+			screen.DrawString(hintX, row, hint, hintStyle)
+			right = hintX - 1
+		}
+		screen.DrawString(col, row, Truncate(item.Label, max(0, right-col)), style)
+	}
+}
+
+// MenuBar is a row of menu titles that open dropdown menus
+//
+// F10 opens the first menu, and alt with a title's first letter opens that
+// menu. While a menu is open the bar takes every key: up and down move,
+// right and left open and close submenus or move between menus, enter
+// chooses and esc closes. Menus draw through the screen's overlay layer, so
+// draw the bar before the content below it.
+type MenuBar struct {
+	menus  []*Menu
+	active int
+	stack  menuStack
+	keymap *Keymap
+}
+
+// NewMenuBar creates a menu bar with the given menus
+func NewMenuBar(menus ...*Menu) *MenuBar {
+	return &MenuBar{
+		menus:  menus,
+		keymap: DefaultMenuBarKeymap(),
+		stack:  menuStack{keymap: DefaultMenuKeymap()},
+	}
+}
+
+// AddMenu appends a menu to the bar
+func (b *MenuBar) AddMenu(menu *Menu) {
+	b.menus = append(b.menus, menu)
+}
+
+// Menus returns the bar's menus
+func (b *MenuBar) Menus() []*Menu {
+	return b.menus
+}
+
+// Keymap returns the bindings for opening the bar
+func (b *MenuBar) Keymap() *Keymap {
+	return b.keymap
+}
+
+// SetKeymap replaces the bindings for opening the bar
+func (b *MenuBar) SetKeymap(keymap *Keymap) {
+	b.keymap = keymap
+}
+
+// MenuKeymap returns the bindings used while a menu is open
+func (b *MenuBar) MenuKeymap() *Keymap {
+	return b.stack.keymap
+}
+
+// SetMenuKeymap replaces the bindings used while a menu is open
+func (b *MenuBar) SetMenuKeymap(keymap *Keymap) {
+	b.stack.keymap = keymap
+}
+
+// SetAccelerators sets the keymaps that item hints are looked up in by
+// action
+func (b *MenuBar) SetAccelerators(keymaps ...*Keymap) {
+	b.stack.accelerators = keymaps
+}
+
+// OnSelect sets a callback for when any item is chosen
+func (b *MenuBar) OnSelect(fn func(item *MenuItem)) {
+	b.stack.onSelect = fn
+}
+
+// Open opens the menu at an index
+func (b *MenuBar) Open(index int) {
+	if index < 0 || index >= len(b.menus) {
+		return
+	}
+	b.active = index
+	b.stack.close()
+	b.stack.open(b.menus[index])
+}
+
+// Close closes any open menu
+func (b *MenuBar) Close() {
+	b.stack.close()
+}
+
+// IsOpen returns whether a menu is open
+func (b *MenuBar) IsOpen() bool {
+	return b.stack.isOpen()
+}
+
+// mnemonic returns the menu opened by an alt+letter key, or -1
+func (b *MenuBar) mnemonic(key string) int {
+	letter, ok := strings.CutPrefix(key, "alt+")
+	if !ok || len([]rune(letter)) != 1 {
+		return -1
+	}
+	for i, menu := range b.menus {
+		if first := []rune(menu.title); len(first) > 0 && strings.EqualFold(string(first[0]), letter) {
+			return i
+		}
+	}
+	return -1
+}
+
+// HandleKey opens menus and moves through them, returning whether the key
+// was used
+func (b *MenuBar) HandleKey(key string) bool {
+	if i := b.mnemonic(key); i >= 0 {
+		b.Open(i)
+		return true
+	}
+	if !b.IsOpen() {
+		if b.keymap.Matches(key, "activate") {
+			b.Open(0)
+			return len(b.menus) > 0
+		}
+		return false
+	}
+	if b.keymap.Matches(key, "activate") {
+		b.Close()
+		return true
+	}
+	if b.stack.handleKey(key) {
+		return true
+	}
+	switch b.stack.keymap.Action(key) {
+	case "open-submenu":
+		b.Open((b.active + 1) % len(b.menus))
+	case "close-submenu":
+		b.Open((b.active - 1 + len(b.menus)) % len(b.menus))
+	}
+	// An open menu takes every key, like a modal
+	return true
+}
+
+// HandleInput processes keyboard input
+func (b *MenuBar) HandleInput(key string) {
+	b.HandleKey(key)
+}
+
+// Measure returns the width of the titles and one row
+func (b *MenuBar) Measure(maxWidth, maxHeight int) (width, height int) {
+	width = 1
+	for _, menu := range b.menus {
+		width += StringWidth(menu.title) + 2
+	}
+	return min(width, maxWidth), min(1, maxHeight)
+}
+
+// Draw renders the titles, and queues the open menu with DeferOverlay
+func (b *MenuBar) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	if availableWidth <= 0 || availableHeight <= 0 {
+		return
+	}
+	style := lipgloss.NewStyle().Background(theme.Palette.Surface).Foreground(theme.Palette.Text)
+	ClearArea(screen, x, y, availableWidth, 1, style)
+
+	col, right := x+1, x+availableWidth
+	var anchor Rectangle
+	for i, menu := range b.menus {
+		title := " " + menu.title + " "
+		width := StringWidth(title)
+		if col+width > right {
+			break
+		}
+		titleStyle := style
+		if b.IsOpen() && i == b.active {
+			titleStyle = style.Background(theme.Palette.Primary).Foreground(theme.Palette.Background).Bold(true)
+			originX, originY := screen.OverlayOrigin()
+			anchor = Rectangle{X: originX + col, Y: originY + y, Width: width, Height: 1}
+		}
+		screen.DrawString(col, y, title, titleStyle)
+		// The first letter is the alt mnemonic
+		if first := []rune(menu.title); len(first) > 0 {
+			screen.DrawRune(col+1, y, first[0], titleStyle.Underline(true))
+		}
+		col += width
+	}
+
+	if b.IsOpen() && anchor.Width > 0 {
+		screen.DeferOverlay(func(root *Screen) {
+			b.stack.draw(root, anchor, theme)
+		})
+	}
+}
+
+// ContextMenu is a menu that opens at a position, such as under the cursor
+//
+// It uses the same keys as an open MenuBar menu and takes every key while
+// open. It takes no space in place and only queues the menu on the screen's
+// overlay layer, so call its Draw directly each frame rather than adding it
+// to a layout, where auto-sized items that measure empty are skipped.
+type ContextMenu struct {
+	menu  *Menu
+	x, y  int
+	stack menuStack
+}
+
+// NewContextMenu creates a closed context menu showing a menu
+func NewContextMenu(menu *Menu) *ContextMenu {
+	return &ContextMenu{
+		menu:  menu,
+		stack: menuStack{keymap: DefaultMenuKeymap()},
+	}
+}
+
+// Menu returns the menu shown
+func (c *ContextMenu) Menu() *Menu {
+	return c.menu
+}
+
+// Keymap returns the bindings used while the menu is open
+func (c *ContextMenu) Keymap() *Keymap {
+	return c.stack.keymap
+}
+
+// SetKeymap replaces the bindings used while the menu is open
+func (c *ContextMenu) SetKeymap(keymap *Keymap) {
+	c.stack.keymap = keymap
+}
+
+// SetAccelerators sets the keymaps that item hints are looked up in by
+// action
+func (c *ContextMenu) SetAccelerators(keymaps ...*Keymap) {
+	c.stack.accelerators = keymaps
+}
+
+// OnSelect sets a callback for when any item is chosen
+func (c *ContextMenu) OnSelect(fn func(item *MenuItem)) {
+	c.stack.onSelect = fn
+}
+
+// Open shows the menu with its top left corner at a position on the root
+// screen
+// Near the bottom it opens upwards instead, and it's shifted left to stay on
+// screen.
+func (c *ContextMenu) Open(x, y int) {
+	c.x, c.y = x, y
+	c.stack.close()
+	c.stack.open(c.menu)
+}
+
+// Close closes the menu
+func (c *ContextMenu) Close() {
+	c.stack.close()
+}
+
+// IsOpen returns whether the menu is showing
+func (c *ContextMenu) IsOpen() bool {
+	return c.stack.isOpen()
+}
+
+// HandleKey moves through the open menu, returning whether the key was
+// used
+func (c *ContextMenu) HandleKey(key string) bool {
+	if !c.IsOpen() {
+		return false
+	}
+	c.stack.handleKey(key)
+	return true
+}
+
+// HandleInput processes keyboard input
+func (c *ContextMenu) HandleInput(key string) {
+	c.HandleKey(key)
+}
+
+// Measure returns nothing; the menu takes no space in place
+func (c *ContextMenu) Measure(maxWidth, maxHeight int) (width, height int) {
+	return 0, 0
+}
+
+// Draw queues the open menu with DeferOverlay
+func (c *ContextMenu) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	if !c.IsOpen() {
+		return
+	}
+	anchor := Rectangle{X: c.x, Y: c.y}
+	screen.DeferOverlay(func(root *Screen) {
+		c.stack.draw(root, anchor, theme)
+	})
+}
@@ -0,0 +1,251 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// newTestMenus returns a File menu with a separator, a disabled item and a
+// checkable item, and an Edit menu with a submenu
+func newTestMenus() (file, edit *Menu) {
+	file = NewMenu("File")
+	file.AddItem("Open", "open")
+	file.AddItem("Save", "save")
+	file.AddSeparator()
+	file.Add(MenuItem{Label: "Revert", Disabled: true})
+	file.AddCheckItem("Auto Save", "auto-save", false)
+
+	edit = NewMenu("Edit")
+	edit.AddItem("Undo", "undo")
+	indent := NewMenu("Indent")
+	indent.AddItem("Tabs", "indent-tabs")
+	indent.AddItem("Spaces", "indent-spaces")
+	edit.AddSubmenu("Indent", indent)
+	return file, edit
+}
+
+func TestMenuNavigationSkipsSeparatorsAndDisabled(t *testing.T) {
+	file, _ := newTestMenus()
+	var chosen []string
+	bar := NewMenuBar(file)
+	bar.OnSelect(func(item *MenuItem) { chosen = append(chosen, item.Action) })
+
+	bar.HandleKey("f10")
+	bar.HandleKey("down") // Save
+	bar.HandleKey("down") // Past the separator and Revert to Auto Save
+	bar.HandleKey("enter")
+	if bar.IsOpen() {
+		t.Error("Expected choosing an item to close the menu")
+	}
+	if len(chosen) != 1 || chosen[0] != "auto-save" {
+		t.Fatalf("Expected auto-save chosen, got %v", chosen)
+	}
+	if !file.Item("auto-save").Checked {
+		t.Error("Expected choosing a checkable item to check it")
+	}
+
+	// Down wraps from the last item to the first; up wraps back
+	bar.Open(0)
+	bar.HandleKey("end")
+	bar.HandleKey("down")
+	bar.HandleKey("up")
+	bar.HandleKey(" ")
+	if file.Item("auto-save").Checked {
+		t.Error("Expected the wrap to land on Auto Save again and uncheck it")
+	}
+}
+
+func TestMenuItemOnSelect(t *testing.T) {
+	menu := NewMenu("File")
+	called := false
+	menu.Add(MenuItem{Label: "Quit", OnSelect: func() { called = true }})
+
+	context := NewContextMenu(menu)
+	context.Open(0, 0)
+	context.HandleKey("enter")
+	if !called || context.IsOpen() {
+		t.Error("Expected the item's callback to run and the menu to close")
+	}
+}
+
+func TestMenuSubmenus(t *testing.T) {
+	file, edit := newTestMenus()
+	var chosen string
+	bar := NewMenuBar(file, edit)
+	bar.OnSelect(func(item *MenuItem) { chosen = item.Action })
+
+	bar.HandleKey("alt+e")
+	bar.HandleKey("down")  // Indent
+	bar.HandleKey("right") // Opens the submenu
+	bar.HandleKey("down")  // Spaces
+	bar.HandleKey("left")  // Back to Edit
+	bar.HandleKey("enter") // Reopens the submenu
+	bar.HandleKey("down")
+	bar.HandleKey("enter")
+	if chosen != "indent-spaces" {
+		t.Errorf("Expected indent-spaces chosen, got %q", chosen)
+	}
+
+	// Esc closes one level at a time
+	bar.Open(1)
+	bar.HandleKey("down")
+	bar.HandleKey("right")
+	bar.HandleKey("esc")
+	if !bar.IsOpen() {
+		t.Error("Expected esc to close only the submenu")
+	}
+	bar.HandleKey("esc")
+	if bar.IsOpen() {
+		t.Error("Expected a second esc to close the menu")
+	}
+}
+
+func TestMenuBarMovesBetweenMenus(t *testing.T) {
+	file, edit := newTestMenus()
+	bar := NewMenuBar(file, edit)
+
+	if bar.HandleKey("down") {
+		t.Error("Expected a closed bar to ignore keys")
+	}
+	bar.HandleKey("f10")
+	bar.HandleKey("right") // Save has no submenu, so on to Edit
+	if bar.active != 1 {
+		t.Errorf("Expected the Edit menu open, got %d", bar.active)
+	}
+	bar.HandleKey("right") // Wraps to File
+	bar.HandleKey("left")  // Wraps back to Edit
+	if bar.active != 1 {
+		t.Errorf("Expected left to wrap to Edit, got %d", bar.active)
+	}
+	if !bar.HandleKey("x") {
+		t.Error("Expected an open menu to take every key")
+	}
+	bar.HandleKey("f10")
+	if bar.IsOpen() {
+		t.Error("Expected f10 to close the menus")
+	}
+}
+
+func TestFormatAccelerator(t *testing.T) {
+	tests := map[string]string{
+		"ctrl+s":       "Ctrl+S",
+		"ctrl+shift+p": "Ctrl+Shift+P",
+		"f10":          "F10",
+		"esc":          "Esc",
+		"p":            "p",
+		"?":            "?",
+		" ":            "Space",
+	}
+	for key, expected := range tests {
+		if got := FormatAccelerator(key); got != expected {
+			t.Errorf("FormatAccelerator(%q): expected %q, got %q", key, expected, got)
+		}
+	}
+}
+
+func TestMenuBarDraw(t *testing.T) {
+	file, edit := newTestMenus()
+	bar := NewMenuBar(file, edit)
+	bar.SetAccelerators(NewKeymap("editor",
+		KeyBinding{Action: "save", Keys: []string{"ctrl+s"}},
+	))
+	file.Item("open").Hint = "…"
+	theme := NewTestTheme()
+
+	sim := NewScreenSimulation(30, 10)
+	bar.Draw(sim.Screen, 0, 0, 30, 1, theme)
+	if line := sim.GetLine(0); line != "  File  Edit" {
+		t.Errorf("Expected the titles, got %q", line)
+	}
+	AssertTextNotExists(t, sim, "Open")
+
+	bar.Open(0)
+	bar.HandleKey("end")
+	bar.HandleKey(" ") // Check Auto Save
+	bar.Open(0)
+	bar.Draw(sim.Screen, 0, 0, 30, 1, theme)
+	// Something drawn later in the tree stays under the menu
+	sim.Screen.DrawString(0, 3, strings.Repeat("x", 30), lipgloss.NewStyle())
+	sim.Screen.DrawOverlays()
+
+	expected := []string{
+		"┌──────────────────────┐",
+		"│   Open             … │",
+		"│   Save        Ctrl+S │",
+		"├──────────────────────┤",
+		"│   Revert             │",
+		"│ ✓ Auto Save          │",
+		"└──────────────────────┘",
+	}
+	for i, want := range expected {
+		// The menu starts under the File title, one column in
+		if line := []rune(sim.GetLine(i + 1)); len(line) < 25 || string(line[1:25]) != want {
+			t.Errorf("Row %d: expected %q, got %q", i+1, want, line)
+		}
+	}
+	// The block shadow sits one cell right and down
+	shadow, _ := sim.Screen.cellAt(24, 8)
+	if shadow.Background != theme.Palette.Shadow {
+		t.Error("Expected a block shadow under the menu")
+	}
+	surface, _ := sim.Screen.cellAt(3, 2)
+	if surface.Background != theme.Palette.Overlay {
+		t.Error("Expected the cursor's item highlighted")
+	}
+	if cell, _ := sim.Screen.cellAt(3, 3); cell.Background != theme.Palette.Surface {
+		t.Error("Expected the menu on the surface color")
+	}
+}
+
+func TestMenuSubmenuDrawsBeside(t *testing.T) {
+	_, edit := newTestMenus()
+	bar := NewMenuBar(edit)
+	bar.Open(0)
+	bar.HandleKey("down")
+	bar.HandleKey("right")
+
+	sim := NewScreenSimulation(40, 8)
+	bar.Draw(sim.Screen, 0, 0, 40, 1, NewTestTheme())
+	sim.Screen.DrawOverlays()
+
+	// The submenu opens right of Edit, its first item on the Indent row
+	AssertTextExists(t, sim, "│ Indent ▸ │")
+	if line := sim.GetLine(2); !strings.HasSuffix(line, "┌────────┐") {
+		t.Errorf("Expected the submenu's top border beside the parent, got %q", line)
+	}
+	if line := sim.GetLine(3); !strings.Contains(line, "▸ ││ Tabs") {
+		t.Errorf("Expected the submenu's first item on the Indent row, got %q", line)
+	}
+}
+
+func TestContextMenuPlacement(t *testing.T) {
+	menu := NewMenu("")
+	menu.AddItem("Copy", "copy")
+	menu.AddItem("Paste", "paste")
+	context := NewContextMenu(menu)
+	theme := NewTestTheme()
+
+	draw := func() *ScreenSimulation {
+		sim := NewScreenSimulation(20, 8)
+		context.Draw(sim.Screen, 0, 0, 20, 8, theme)
+		sim.Screen.DrawOverlays()
+		return sim
+	}
+
+	if sim := draw(); strings.TrimSpace(sim.GetContent()) != "" {
+		t.Error("Expected a closed context menu to draw nothing")
+	}
+
+	context.Open(3, 1)
+	sim := draw()
+	AssertCellRune(t, sim, 3, 1, '┌')
+	AssertTextExists(t, sim, "│ Copy  │")
+
+	// Near the bottom right it opens upwards and shifts left
+	context.Open(18, 6)
+	sim = draw()
+	AssertCellRune(t, sim, 11, 2, '┌')
+	AssertCellRune(t, sim, 19, 5, '┘')
+}
@@ -5,14 +5,19 @@ import (
 )
 
 // Modal represents a modal dialog component that provides an elevated surface
-// It should contain Container components for structure and content
+// Its content, usually a Container, is laid out to fill the modal
 type Modal struct {
-	visible  bool
-	width    int
-	height   int
-	focused  bool
-	centered bool
-	x, y     int // Position (used when not centered)
+	visible       bool
+	width         int
+	height        int
+	focused       bool
+	centered      bool
+	x, y          int // Position (used when not centered)
+	content       Component
+	widthSize     *ConstraintSet // Sizes from the available space when set
+	heightSize    *ConstraintSet
+	dimBackground bool
+	rect          Rectangle // Where the modal was last drawn
 }
 
 // NewModal creates a new modal dialog
@@ -58,35 +63,89 @@ func (m *Modal) SetPosition(x, y int) {
 	m.centered = false
 }
 
-// Draw renders the modal to the screen at the specified position
-func (m *Modal) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
-	if !m.visible {
-		return
-	}
+// SetContent sets the component drawn inside the modal, usually a Container
+func (m *Modal) SetContent(content Component) {
+	m.content = content
+}
 
+// GetContent returns the component drawn inside the modal
+func (m *Modal) GetContent() Component {
+	return m.content
+}
+
+// SetSizeConstraints sizes the modal from the space it is drawn in
+// For example, NewConstraintSet(NewPercentage(0.6)).WithMin(30).WithMax(80)
+// takes 60% of the screen width but stays between 30 and 80 cells. Auto
+// uses the content's measured size.
+func (m *Modal) SetSizeConstraints(width, height ConstraintSet) {
+	m.widthSize = &width
+	m.heightSize = &height
+}
+
+// SetPercentSize sizes the modal as a percentage (0.0 to 1.0) of the space
+// it is drawn in
+func (m *Modal) SetPercentSize(widthPct, heightPct float64) {
+	m.SetSizeConstraints(NewConstraintSet(NewPercentage(widthPct)), NewConstraintSet(NewPercentage(heightPct)))
+}
+
+// SetDimBackground sets whether everything behind the modal is dimmed
+func (m *Modal) SetDimBackground(dim bool) {
+	m.dimBackground = dim
+}
+
+// Bounds returns the rectangle the modal occupies when drawn in the given
+// space
+func (m *Modal) Bounds(x, y, availableWidth, availableHeight int) Rectangle {
 	// Modal decides its own size (doesn't use all available space)
-	// Use the modal's preferred dimensions
+	// Use the modal's preferred dimensions unless constraints are set
 	modalWidth := m.width
 	modalHeight := m.height
-	
-	// Constrain to available space if needed
-	if modalWidth > availableWidth {
-		modalWidth = availableWidth
-	}
-	if modalHeight > availableHeight {
-		modalHeight = availableHeight
+	if m.widthSize != nil || m.heightSize != nil {
+		measuredWidth, measuredHeight := MeasureComponent(m.content, availableWidth, availableHeight)
+		if m.widthSize != nil {
+			modalWidth = m.widthSize.resolveAuto(measuredWidth).Calculate(availableWidth, 1)
+		}
+		if m.heightSize != nil {
+			modalHeight = m.heightSize.resolveAuto(measuredHeight).Calculate(availableHeight, 1)
+		}
 	}
 
+	// Constrain to available space if needed
+	modalWidth = max(0, min(modalWidth, availableWidth))
+	modalHeight = max(0, min(modalHeight, availableHeight))
+
 	// Calculate actual position
 	actualX, actualY := x, y
 	if m.centered {
 		// Center within available space
-		actualX = x + (availableWidth - modalWidth) / 2
-		actualY = y + (availableHeight - modalHeight) / 2
+		actualX = x + (availableWidth-modalWidth)/2
+		actualY = y + (availableHeight-modalHeight)/2
 	} else if x == 0 && y == 0 {
 		actualX, actualY = m.x, m.y
 	}
 
+	return Rectangle{X: actualX, Y: actualY, Width: modalWidth, Height: modalHeight}
+}
+
+// GetRect returns where the modal was last drawn
+func (m *Modal) GetRect() Rectangle {
+	return m.rect
+}
+
+// Draw renders the modal and its content to the screen
+func (m *Modal) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	if !m.visible {
+		return
+	}
+
+	m.rect = m.Bounds(x, y, availableWidth, availableHeight)
+	actualX, actualY := m.rect.X, m.rect.Y
+	modalWidth, modalHeight := m.rect.Width, m.rect.Height
+
+	if m.dimBackground {
+		screen.DimArea(x, y, availableWidth, availableHeight)
+	}
+
 	// Clear the modal area with surface color first
 	surfaceStyle := lipgloss.NewStyle().Background(theme.Palette.Surface)
 	ClearArea(screen, actualX, actualY, modalWidth, modalHeight, surfaceStyle)
@@ -100,16 +159,32 @@ func (m *Modal) Draw(screen *Screen, x, y, availableWidth, availableHeight int,
 
 	// Use the new DrawBlockShadow method
 	screen.DrawBlockShadow(actualX, actualY, modalWidth, modalHeight, shadowStyle, shadowOffsetX, shadowOffsetY)
+
+	// Content fills the modal surface
+	if m.content != nil {
+		screen.DrawComponent(m.content, actualX, actualY, modalWidth, modalHeight, theme)
+	}
+}
+
+// Children returns the modal's content
+// Hidden modals are skipped entirely when discovering focus.
+func (m *Modal) Children() []Component {
+	if m.content != nil {
+		return []Component{m.content}
+	}
+	return nil
 }
 
 // Focus gives keyboard focus to this component
 func (m *Modal) Focus() {
 	m.focused = true
+	focusFirst(m.Children())
 }
 
 // Blur removes keyboard focus from this component
 func (m *Modal) Blur() {
 	m.focused = false
+	blurAll(m.Children())
 }
 
 // IsFocused returns whether this component currently has focus
@@ -118,13 +193,25 @@ func (m *Modal) IsFocused() bool {
 }
 
 // HandleInput processes keyboard input
+// Esc hides the modal; other keys go to the content. Without content, enter
+// hides it too.
 func (m *Modal) HandleInput(key string) {
-	switch key {
-	case "esc", "enter":
+	switch {
+	case key == "esc":
+		m.Hide()
+	case m.content != nil:
+		m.content.HandleInput(key)
+	case key == "enter":
 		m.Hide()
 	}
 }
 
+// Measure returns the modal's preferred size
+func (m *Modal) Measure(maxWidth, maxHeight int) (width, height int) {
+	rect := m.Bounds(0, 0, maxWidth, maxHeight)
+	return rect.Width, rect.Height
+}
+
 // SetSize sets the width and height of the component
 func (m *Modal) SetSize(width, height int) {
 	m.width = width
@@ -0,0 +1,120 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestModalLaysOutContent(t *testing.T) {
+	screen := NewScreenSimulation(40, 20)
+	theme := NewTestTheme()
+
+	content := &mockComponent{id: "content"}
+	modal := NewModal()
+	modal.SetSize(20, 6)
+	modal.SetContent(content)
+	modal.Show()
+	modal.Draw(screen.Screen, 0, 0, 40, 20, theme)
+
+	expected := Rectangle{X: 10, Y: 7, Width: 20, Height: 6}
+	if modal.GetRect() != expected {
+		t.Errorf("Expected modal at %v, got %v", expected, modal.GetRect())
+	}
+	if content.width != 20 || content.height != 6 {
+		t.Errorf("Expected content to fill the modal, got %dx%d", content.width, content.height)
+	}
+
+	// Content draws through a sub-screen, so it can't spill outside
+	container := NewContainer()
+	container.SetTitle("Hello")
+	modal.SetContent(container)
+	screen.Clear()
+	modal.Draw(screen.Screen, 0, 0, 40, 20, theme)
+	AssertCellRune(t, screen, 10, 7, '┌')
+	AssertCellRune(t, screen, 29, 12, '┘')
+	AssertTextExists(t, screen, "Hello")
+}
+
+func TestModalSizeConstraints(t *testing.T) {
+	modal := NewModal()
+	modal.SetSizeConstraints(
+		NewConstraintSet(NewPercentage(0.5)).WithMin(30).WithMax(60),
+		NewConstraintSet(NewPercentage(0.5)),
+	)
+
+	tests := []struct {
+		width, height int
+		expected      Rectangle
+	}{
+		{80, 24, Rectangle{X: 20, Y: 6, Width: 40, Height: 12}},
+		{40, 10, Rectangle{X: 5, Y: 2, Width: 30, Height: 5}},     // Minimum width
+		{200, 50, Rectangle{X: 70, Y: 12, Width: 60, Height: 25}}, // Maximum width
+		{20, 10, Rectangle{X: 0, Y: 2, Width: 20, Height: 5}},     // Never larger than the screen
+	}
+	for _, tt := range tests {
+		if rect := modal.Bounds(0, 0, tt.width, tt.height); rect != tt.expected {
+			t.Errorf("%dx%d: expected %v, got %v", tt.width, tt.height, tt.expected, rect)
+		}
+	}
+
+	// Auto sizes to the content
+	input := NewInput()
+	input.SetPlaceholder("Search files")
+	modal.SetSizeConstraints(NewConstraintSet(NewAuto()), NewConstraintSet(NewLength(3)))
+	modal.SetContent(input)
+	if rect := modal.Bounds(0, 0, 80, 24); rect.Width != 12 || rect.Height != 3 {
+		t.Errorf("Expected auto size 12x3, got %dx%d", rect.Width, rect.Height)
+	}
+}
+
+func TestModalDimBackground(t *testing.T) {
+	screen := NewScreenSimulation(20, 10)
+	theme := NewTestTheme()
+	screen.DrawString(0, 0, "behind", lipgloss.NewStyle())
+
+	modal := NewModal()
+	modal.SetSize(6, 4)
+	modal.SetDimBackground(true)
+	modal.Show()
+	modal.Draw(screen.Screen, 0, 0, 20, 10, theme)
+
+	if cell := screen.GetCell(0, 0); !cell.Dim || cell.Rune != 'b' {
+		t.Errorf("Expected text behind the modal to be dimmed, got %+v", cell)
+	}
+	rect := modal.GetRect()
+	if cell := screen.GetCell(rect.X, rect.Y); cell.Dim {
+		t.Error("Expected the modal itself not to be dimmed")
+	}
+}
+
+func TestModalRoutesInputToContent(t *testing.T) {
+	input := NewInput()
+	modal := NewModal()
+	modal.SetContent(input)
+	modal.Show()
+
+	modal.Focus()
+	if !input.IsFocused() {
+		t.Error("Expected focusing the modal to focus its content")
+	}
+
+	modal.HandleInput("a")
+	modal.HandleInput("enter")
+	if input.Value() != "a" || !modal.IsVisible() {
+		t.Errorf("Expected keys to reach the content, got %q", input.Value())
+	}
+
+	// Focus discovery finds the content of a visible modal only
+	if targets := FocusTargets(modal); len(targets) != 1 || targets[0] != Component(input) {
+		t.Errorf("Expected the input as the only focus target, got %v", targets)
+	}
+
+	modal.HandleInput("esc")
+	if modal.IsVisible() {
+		t.Error("Expected esc to hide the modal")
+	}
+	if targets := FocusTargets(modal); len(targets) != 0 {
+		t.Errorf("Expected no focus targets in a hidden modal, got %v", targets)
+	}
+}
@@ -3,6 +3,7 @@ package tui
 import (
 	"time"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -37,6 +38,8 @@ type Notification struct {
 	height    int
 	position  NotificationPosition
 	focused   bool
+	timer     *Timer
+	keymap    *Keymap
 }
 
 // NewNotification creates a new notification
@@ -49,9 +52,34 @@ func NewNotification() *Notification {
 		height:    4,
 		position:  NotificationBottomRight,
 		focused:   false,
+		timer:     NewTimer(nil),
+		keymap:    DefaultNotificationKeymap(),
 	}
 }
 
+// DefaultNotificationKeymap returns the default key bindings of a
+// Notification
+func DefaultNotificationKeymap() *Keymap {
+	return NewKeymap("notification",
+		KeyBinding{Action: "dismiss", Keys: []string{"esc", "enter"}, Help: "dismiss"},
+	)
+}
+
+// Keymap returns the notification's key bindings
+func (n *Notification) Keymap() *Keymap {
+	return n.keymap
+}
+
+// SetKeymap sets the notification's key bindings
+func (n *Notification) SetKeymap(keymap *Keymap) {
+	n.keymap = keymap
+}
+
+// SetClock sets the clock used for auto-hiding
+func (n *Notification) SetClock(clock Clock) {
+	n.timer.SetClock(clock)
+}
+
 // SetPosition sets where the notification appears
 func (n *Notification) SetPosition(pos NotificationPosition) {
 	n.position = pos
@@ -66,7 +94,7 @@ func (n *Notification) SetDuration(duration time.Duration) {
 func (n *Notification) Show(message string, notifType NotificationType) {
 	n.message = message
 	n.notifType = notifType
-	n.timestamp = time.Now()
+	n.timestamp = n.timer.Clock().Now()
 	n.visible = true
 }
 
@@ -93,13 +121,33 @@ func (n *Notification) ShowInfo(message string) {
 // Hide immediately hides the notification
 func (n *Notification) Hide() {
 	n.visible = false
+	n.timer.Stop()
 }
 
 // Update checks if the notification should auto-hide
 func (n *Notification) Update() {
-	if n.visible && n.duration > 0 && time.Since(n.timestamp) > n.duration {
-		n.visible = false
+	if n.visible && n.duration > 0 && !n.timer.Clock().Now().Before(n.timestamp.Add(n.duration)) {
+		n.Hide()
+	}
+}
+
+// TimerCmd returns a command that fires when the notification should hide
+// Call it after Show and return it from your Update function.
+func (n *Notification) TimerCmd() tea.Cmd {
+	if !n.visible || n.duration <= 0 {
+		n.timer.Stop()
+		return nil
 	}
+	return n.timer.Schedule(n.timestamp.Add(n.duration))
+}
+
+// HandleTimer hides the notification when its timer fires
+func (n *Notification) HandleTimer(msg TimerMsg) tea.Cmd {
+	if !n.timer.Fired(msg) {
+		return nil
+	}
+	n.Update()
+	return n.TimerCmd()
 }
 
 // IsVisible returns whether the notification is visible
@@ -117,17 +165,7 @@ func (n *Notification) Draw(screen *Screen, x, y int, theme *Theme) {
 	actualX, actualY := n.calculatePosition(screen)
 
 	// Get notification style based on type
-	var notifStyle NotificationStyle
-	switch n.notifType {
-	case NotificationSuccess:
-		notifStyle = NotificationStyles.Success
-	case NotificationWarning:
-		notifStyle = NotificationStyles.Warning
-	case NotificationError:
-		notifStyle = NotificationStyles.Error
-	default:
-		notifStyle = NotificationStyles.Info
-	}
+	notifStyle := NotificationStyleFor(theme, n.notifType)
 
 	// Draw block shadow with neo-brutalist style (offset by 1 cell)
 	shadowStyle := lipgloss.NewStyle().
@@ -209,8 +247,8 @@ func (n *Notification) IsFocused() bool {
 
 // HandleKey processes keyboard input when focused
 func (n *Notification) HandleKey(key string) bool {
-	switch key {
-	case "esc", "enter":
+	switch n.keymap.Action(key) {
+	case "dismiss":
 		n.Hide()
 		return true
 	}
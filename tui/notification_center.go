@@ -0,0 +1,477 @@
+package tui
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// NotificationAction is a key that acts on a notification, such as "u: undo"
+type NotificationAction struct {
+	Key     string
+	Label   string
+	Handler func()
+}
+
+// Toast is a single notification in a NotificationCenter
+type Toast struct {
+	ID       int
+	Title    string // Defaults to the type's name
+	Message  string
+	Type     NotificationType
+	Duration time.Duration // 0 uses the center's default; negative never expires
+	Actions  []NotificationAction
+	Created  time.Time
+	Closed   time.Time // When the toast was dismissed or expired; zero while shown
+
+	progress    float64
+	hasProgress bool
+}
+
+// Progress returns the task progress set with NotificationCenter.SetProgress
+// and whether any was set
+func (t Toast) Progress() (float64, bool) {
+	return t.progress, t.hasProgress
+}
+
+// NotificationCenter shows a stack of toasts in a corner of the screen
+//
+// Each toast expires after its own duration, shown as a countdown bar along
+// its bottom edge, and can offer action keys. Dismissed and expired toasts
+// are kept in a history log. Colors come from the theme palette.
+type NotificationCenter struct {
+	toasts          []Toast
+	history         []Toast
+	historyLimit    int
+	nextID          int
+	position        NotificationPosition
+	width           int
+	maxVisible      int
+	defaultDuration time.Duration
+	timer           *Timer
+}
+
+// NewNotificationCenter creates an empty notification center
+func NewNotificationCenter() *NotificationCenter {
+	return &NotificationCenter{
+		historyLimit:    100,
+		nextID:          1,
+		position:        NotificationBottomRight,
+		width:           36,
+		maxVisible:      5,
+		defaultDuration: 3 * time.Second,
+		timer:           NewTimer(nil),
+	}
+}
+
+// SetClock sets the clock used for durations and countdowns
+func (nc *NotificationCenter) SetClock(clock Clock) {
+	nc.timer.SetClock(clock)
+}
+
+// now returns the current time on the center's clock
+func (nc *NotificationCenter) now() time.Time {
+	return nc.timer.Clock().Now()
+}
+
+// SetPosition sets the corner toasts stack in
+func (nc *NotificationCenter) SetPosition(pos NotificationPosition) {
+	nc.position = pos
+}
+
+// SetWidth sets the width of each toast
+func (nc *NotificationCenter) SetWidth(width int) {
+	nc.width = width
+}
+
+// SetMaxVisible sets how many toasts are shown at once
+// Older toasts stay queued until newer ones go away.
+func (nc *NotificationCenter) SetMaxVisible(n int) {
+	nc.maxVisible = n
+}
+
+// SetDefaultDuration sets how long toasts without their own duration stay
+func (nc *NotificationCenter) SetDefaultDuration(duration time.Duration) {
+	nc.defaultDuration = duration
+}
+
+// SetHistoryLimit sets how many past toasts the history keeps
+func (nc *NotificationCenter) SetHistoryLimit(limit int) {
+	nc.historyLimit = limit
+	nc.trimHistory()
+}
+
+// Push shows a toast and returns its ID
+func (nc *NotificationCenter) Push(toast Toast) int {
+	toast.ID = nc.nextID
+	nc.nextID++
+	toast.Created = nc.now()
+	toast.Closed = time.Time{}
+	if toast.Duration == 0 {
+		toast.Duration = nc.defaultDuration
+	}
+	if toast.Title == "" {
+		toast.Title = toast.Type.String()
+	}
+	nc.toasts = append(nc.toasts, toast)
+	return toast.ID
+}
+
+// Show shows a message and returns the toast's ID
+func (nc *NotificationCenter) Show(message string, notifType NotificationType) int {
+	return nc.Push(Toast{Message: message, Type: notifType})
+}
+
+// ShowSuccess shows a success message
+func (nc *NotificationCenter) ShowSuccess(message string) int {
+	return nc.Show(message, NotificationSuccess)
+}
+
+// ShowWarning shows a warning message
+func (nc *NotificationCenter) ShowWarning(message string) int {
+	return nc.Show(message, NotificationWarning)
+}
+
+// ShowError shows an error message
+func (nc *NotificationCenter) ShowError(message string) int {
+	return nc.Show(message, NotificationError)
+}
+
+// ShowInfo shows an info message
+func (nc *NotificationCenter) ShowInfo(message string) int {
+	return nc.Show(message, NotificationInfo)
+}
+
+// SetProgress shows a task's progress, from 0 to 1, in place of a toast's
+// countdown
+// Returns false if the toast isn't shown.
+func (nc *NotificationCenter) SetProgress(id int, progress float64) bool {
+	i := nc.indexOf(id)
+	if i < 0 {
+		return false
+	}
+	nc.toasts[i].progress = max(0, min(progress, 1))
+	nc.toasts[i].hasProgress = true
+	return true
+}
+
+// Dismiss closes a toast
+// Returns false if the toast isn't shown.
+func (nc *NotificationCenter) Dismiss(id int) bool {
+	i := nc.indexOf(id)
+	if i < 0 {
+		return false
+	}
+	nc.close(i, nc.now())
+	return true
+}
+
+// DismissAll closes every toast
+func (nc *NotificationCenter) DismissAll() {
+	now := nc.now()
+	for len(nc.toasts) > 0 {
+		nc.close(0, now)
+	}
+}
+
+// Update closes expired toasts
+func (nc *NotificationCenter) Update() {
+	now := nc.now()
+	for i := 0; i < len(nc.toasts); {
+		if nc.expired(nc.toasts[i], now) {
+			nc.close(i, now)
+		} else {
+			i++
+		}
+	}
+}
+
+// TimerCmd returns a command that fires at the next moment a toast expires
+// or its countdown bar shrinks
+// Call it after showing toasts and return it from your Update function.
+func (nc *NotificationCenter) TimerCmd() tea.Cmd {
+	deadline, ok := nc.nextDeadline(nc.now())
+	if !ok {
+		nc.timer.Stop()
+		return nil
+	}
+	return nc.timer.Schedule(deadline)
+}
+
+// HandleTimer closes expired toasts when the center's timer fires
+func (nc *NotificationCenter) HandleTimer(msg TimerMsg) tea.Cmd {
+	if !nc.timer.Fired(msg) {
+		return nil
+	}
+	nc.Update()
+	return nc.TimerCmd()
+}
+
+// nextDeadline returns when the toasts next change on their own
+func (nc *NotificationCenter) nextDeadline(now time.Time) (time.Time, bool) {
+	var next time.Time
+	found := false
+	consider := func(t time.Time) {
+		if !found || t.Before(next) {
+			next, found = t, true
+		}
+	}
+
+	for _, toast := range nc.toasts {
+		if toast.Duration >= 0 {
+			consider(toast.Created.Add(toast.Duration))
+		}
+	}
+	// The countdown bar loses a cell every Duration/barWidth
+	barWidth := time.Duration(max(nc.width-2, 1))
+	for _, toast := range nc.visible() {
+		if toast.Duration <= 0 || toast.hasProgress {
+			continue
+		}
+		step := max(toast.Duration/barWidth, time.Millisecond)
+		elapsed := now.Sub(toast.Created)
+		consider(toast.Created.Add((elapsed/step + 1) * step))
+	}
+	return next, found
+}
+
+// Toasts returns the toasts being shown or queued, oldest first
+func (nc *NotificationCenter) Toasts() []Toast {
+	return append([]Toast(nil), nc.toasts...)
+}
+
+// History returns past toasts, oldest first
+func (nc *NotificationCenter) History() []Toast {
+	return append([]Toast(nil), nc.history...)
+}
+
+// ClearHistory empties the history log
+func (nc *NotificationCenter) ClearHistory() {
+	nc.history = nil
+}
+
+// IsVisible returns whether any toast is shown
+func (nc *NotificationCenter) IsVisible() bool {
+	return len(nc.toasts) > 0
+}
+
+// Remaining returns how long a toast has left before it expires
+// Toasts that never expire return a negative duration.
+func (nc *NotificationCenter) Remaining(id int) time.Duration {
+	i := nc.indexOf(id)
+	if i < 0 {
+		return 0
+	}
+	toast := nc.toasts[i]
+	if toast.Duration < 0 {
+		return -1
+	}
+	return max(0, toast.Created.Add(toast.Duration).Sub(nc.now()))
+}
+
+// HandleKey runs the action bound to a key on the newest visible toast that
+// has one, and dismisses that toast
+// Returns true if the key was handled
+func (nc *NotificationCenter) HandleKey(key string) bool {
+	visible := nc.visible()
+	for i := len(visible) - 1; i >= 0; i-- {
+		for _, action := range visible[i].Actions {
+			if action.Key != key {
+				continue
+			}
+			nc.Dismiss(visible[i].ID)
+			if action.Handler != nil {
+				action.Handler()
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// HandleInput processes keyboard input
+func (nc *NotificationCenter) HandleInput(key string) {
+	nc.HandleKey(key)
+}
+
+// visible returns the toasts that are drawn, oldest first
+func (nc *NotificationCenter) visible() []Toast {
+	if nc.maxVisible > 0 && len(nc.toasts) > nc.maxVisible {
+		return nc.toasts[len(nc.toasts)-nc.maxVisible:]
+	}
+	return nc.toasts
+}
+
+// indexOf returns the position of a shown toast, or -1
+func (nc *NotificationCenter) indexOf(id int) int {
+	for i, toast := range nc.toasts {
+		if toast.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// expired returns whether a toast's duration has passed
+func (nc *NotificationCenter) expired(toast Toast, now time.Time) bool {
+	return toast.Duration >= 0 && !now.Before(toast.Created.Add(toast.Duration))
+}
+
+// close moves a shown toast to the history
+func (nc *NotificationCenter) close(i int, now time.Time) {
+	toast := nc.toasts[i]
+	toast.Closed = now
+	nc.toasts = append(nc.toasts[:i], nc.toasts[i+1:]...)
+	nc.history = append(nc.history, toast)
+	nc.trimHistory()
+}
+
+// trimHistory drops the oldest history entries beyond the limit
+func (nc *NotificationCenter) trimHistory() {
+	if nc.historyLimit >= 0 && len(nc.history) > nc.historyLimit {
+		nc.history = append([]Toast(nil), nc.history[len(nc.history)-nc.historyLimit:]...)
+	}
+}
+
+// Draw renders the visible toasts stacked in the chosen corner of the area
+// The newest toast sits closest to the corner.
+func (nc *NotificationCenter) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	const marginX, marginY = 2, 1
+	width := min(nc.width, availableWidth-2*marginX)
+	if width < 8 {
+		return
+	}
+
+	left := x + availableWidth - width - marginX
+	if nc.position == NotificationBottomLeft || nc.position == NotificationTopLeft {
+		left = x + marginX
+	}
+	top := nc.position == NotificationTopLeft || nc.position == NotificationTopRight
+
+	now := nc.now()
+	visible := nc.visible()
+	row := y + availableHeight - marginY
+	if top {
+		row = y + marginY
+	}
+	for i := len(visible) - 1; i >= 0; i-- {
+		toast := visible[i]
+		lines := Wrap(toast.Message, width-4)
+		height := len(lines) + 2
+		if len(toast.Actions) > 0 {
+			height++
+		}
+
+		// Leave a row between toasts for the shadow
+		toastY := row - height - 1
+		if top {
+			toastY = row
+			row += height + 1
+		} else {
+			row = toastY
+		}
+		if toastY < y || toastY+height > y+availableHeight {
+			break
+		}
+		nc.drawToast(screen, toast, lines, left, toastY, width, height, now, theme)
+	}
+}
+
+// drawToast renders a single toast
+func (nc *NotificationCenter) drawToast(screen *Screen, toast Toast, lines []string, x, y, width, height int, now time.Time, theme *Theme) {
+	style := NotificationStyleFor(theme, toast.Type)
+	surface := lipgloss.NewStyle().Background(theme.Palette.Surface)
+	accent := surface.Foreground(style.Border)
+
+	shadowStyle := lipgloss.NewStyle().
+		Foreground(theme.Palette.Shadow).
+		Background(theme.Palette.Background)
+	screen.DrawBlockShadow(x, y, width, height, shadowStyle, 1, 1)
+	ClearArea(screen, x, y, width, height, surface)
+	screen.DrawBox(x, y, width, height, accent)
+
+	title := Truncate(fmt.Sprintf(" %s %s ", style.Icon, toast.Title), width-4)
+	screen.DrawString(x+2, y, title, accent.Bold(true))
+
+	textStyle := surface.Foreground(style.Text)
+	for i, line := range lines {
+		screen.DrawString(x+2, y+1+i, line, textStyle)
+	}
+
+	if len(toast.Actions) > 0 {
+		col := x + 2
+		keyStyle := accent.Bold(true)
+		labelStyle := surface.Foreground(theme.Palette.TextMuted)
+		for i, action := range toast.Actions {
+			if i > 0 {
+				col += 2
+			}
+			label := ": " + action.Label
+			if col+StringWidth(action.Key)+StringWidth(label) > x+width-2 {
+				break
+			}
+			screen.DrawString(col, y+height-2, action.Key, keyStyle)
+			col += StringWidth(action.Key)
+			screen.DrawString(col, y+height-2, label, labelStyle)
+			col += StringWidth(label)
+		}
+	}
+
+	// The bottom border doubles as a countdown or progress bar
+	fraction := -1.0
+	if toast.hasProgress {
+		fraction = toast.progress
+	} else if toast.Duration > 0 {
+		left := toast.Created.Add(toast.Duration).Sub(now)
+		fraction = max(0, min(float64(left)/float64(toast.Duration), 1))
+	}
+	if fraction >= 0 {
+		barWidth := width - 2
+		filled := int(math.Ceil(float64(barWidth)*fraction - 1e-9))
+		screen.DrawString(x+1, y+height-1, strings.Repeat("━", filled), accent)
+		if toast.hasProgress {
+			percent := fmt.Sprintf(" %d%% ", int(toast.progress*100+0.5))
+			screen.DrawString(x+width-2-StringWidth(percent), y+height-1, percent, accent)
+		}
+	}
+}
+
+// String returns the name of the notification type
+func (t NotificationType) String() string {
+	switch t {
+	case NotificationSuccess:
+		return "Success"
+	case NotificationWarning:
+		return "Warning"
+	case NotificationError:
+		return "Error"
+	case NotificationInfo:
+		return "Info"
+	}
+	return fmt.Sprintf("NotificationType(%d)", int(t))
+}
+
+// NotificationStyleFor returns the style of a notification type in a theme
+// Success uses the Pine palette role, warnings Gold, errors Love and info Foam.
+func NotificationStyleFor(theme *Theme, t NotificationType) NotificationStyle {
+	var style NotificationStyle
+	var accent lipgloss.TerminalColor
+	switch t {
+	case NotificationSuccess:
+		style, accent = NotificationStyles.Success, theme.Palette.Pine
+	case NotificationWarning:
+		style, accent = NotificationStyles.Warning, theme.Palette.Gold
+	case NotificationError:
+		style, accent = NotificationStyles.Error, theme.Palette.Love
+	default:
+		style, accent = NotificationStyles.Info, theme.Palette.Foam
+	}
+	style.Border = accent
+	style.Title = accent
+	style.Text = theme.Palette.Text
+	return style
+}
@@ -0,0 +1,157 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestNotificationCenter creates a notification center with a clock the
+// test controls
+func newTestNotificationCenter() (*NotificationCenter, *FakeClock) {
+	clock := NewFakeClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	nc := NewNotificationCenter()
+	nc.SetClock(clock)
+	return nc, clock
+}
+
+func TestNotificationCenterExpiresPerToast(t *testing.T) {
+	nc, clock := newTestNotificationCenter()
+	short := nc.Push(Toast{Message: "Saved", Type: NotificationSuccess, Duration: time.Second})
+	long := nc.ShowInfo("Indexing") // Default duration of 3s
+	sticky := nc.Push(Toast{Message: "Offline", Type: NotificationError, Duration: -1})
+
+	clock.Advance(1500 * time.Millisecond)
+	nc.Update()
+	if nc.indexOf(short) >= 0 || nc.indexOf(long) < 0 {
+		t.Fatalf("Expected only the 1s toast to expire, got %+v", nc.Toasts())
+	}
+	if remaining := nc.Remaining(long); remaining != 1500*time.Millisecond {
+		t.Errorf("Expected 1.5s remaining, got %v", remaining)
+	}
+
+	clock.Advance(time.Hour)
+	nc.Update()
+	toasts := nc.Toasts()
+	if len(toasts) != 1 || toasts[0].ID != sticky {
+		t.Fatalf("Expected only the sticky toast to remain, got %+v", toasts)
+	}
+
+	history := nc.History()
+	if len(history) != 2 || history[0].ID != short || history[1].ID != long {
+		t.Fatalf("Expected both expired toasts in history, got %+v", history)
+	}
+	if history[1].Title != "Info" || history[1].Closed.IsZero() {
+		t.Errorf("Expected a titled, closed history entry, got %+v", history[1])
+	}
+}
+
+func TestNotificationCenterActions(t *testing.T) {
+	nc, _ := newTestNotificationCenter()
+	undone := false
+	nc.ShowInfo("Older")
+	id := nc.Push(Toast{
+		Message: "Deleted main.go",
+		Type:    NotificationWarning,
+		Actions: []NotificationAction{{Key: "u", Label: "undo", Handler: func() { undone = true }}},
+	})
+
+	if nc.HandleKey("x") {
+		t.Error("Expected unbound keys to pass through")
+	}
+	if !nc.HandleKey("u") || !undone {
+		t.Fatal("Expected u to run the undo action")
+	}
+	if nc.indexOf(id) >= 0 {
+		t.Error("Expected the toast to be dismissed after its action ran")
+	}
+	if nc.HandleKey("u") {
+		t.Error("Expected the action to be gone with its toast")
+	}
+}
+
+func TestNotificationCenterHistoryLimit(t *testing.T) {
+	nc, _ := newTestNotificationCenter()
+	nc.SetHistoryLimit(2)
+	for _, message := range []string{"one", "two", "three"} {
+		nc.Dismiss(nc.ShowInfo(message))
+	}
+
+	history := nc.History()
+	if len(history) != 2 || history[0].Message != "two" || history[1].Message != "three" {
+		t.Errorf("Expected the two newest entries, got %+v", history)
+	}
+	nc.ClearHistory()
+	if len(nc.History()) != 0 {
+		t.Error("Expected history to be cleared")
+	}
+}
+
+func TestNotificationCenterDrawStacks(t *testing.T) {
+	screen := NewScreenSimulation(60, 20)
+	theme := NewTestTheme()
+	nc, clock := newTestNotificationCenter()
+	nc.SetWidth(30)
+	nc.ShowSuccess("First")
+	nc.Push(Toast{
+		Message: "Second",
+		Type:    NotificationWarning,
+		Actions: []NotificationAction{{Key: "u", Label: "undo"}},
+	})
+
+	nc.Draw(screen.Screen, 0, 0, 60, 20, theme)
+
+	// Newest toast is closest to the bottom-right corner
+	AssertCellRune(t, screen, 57, 17, '┘')
+	AssertTextExists(t, screen, "Second")
+	AssertTextExists(t, screen, "u: undo")
+	AssertTextExists(t, screen, "✓ Success")
+	AssertCellRune(t, screen, 30, 15, 'S')
+	if _, firstY, _ := screen.FindText("First"); firstY >= 15 {
+		t.Errorf("Expected the older toast above the newer one, got row %d", firstY)
+	}
+
+	// Full countdown bar, then half
+	if count := strings.Count(screen.GetLine(17), "━"); count != 28 {
+		t.Errorf("Expected a full countdown bar, got %d cells", count)
+	}
+	clock.Advance(1500 * time.Millisecond)
+	screen.Clear()
+	nc.Draw(screen.Screen, 0, 0, 60, 20, theme)
+	if count := strings.Count(screen.GetLine(17), "━"); count != 14 {
+		t.Errorf("Expected a half countdown bar, got %d cells", count)
+	}
+
+	// Top-left stacks downwards, newest first
+	nc.SetPosition(NotificationTopLeft)
+	screen.Clear()
+	nc.Draw(screen.Screen, 0, 0, 60, 20, theme)
+	AssertCellRune(t, screen, 2, 1, '┌')
+	if _, secondY, _ := screen.FindText("Second"); secondY != 2 {
+		t.Errorf("Expected the newest toast at the top, got row %d", secondY)
+	}
+}
+
+func TestNotificationCenterProgress(t *testing.T) {
+	screen := NewScreenSimulation(40, 10)
+	nc, _ := newTestNotificationCenter()
+	nc.SetWidth(22)
+	id := nc.Push(Toast{Message: "Uploading", Duration: -1})
+	nc.SetProgress(id, 0.5)
+
+	nc.Draw(screen.Screen, 0, 0, 40, 10, NewTestTheme())
+	AssertTextExists(t, screen, " 50% ")
+	if progress, ok := nc.Toasts()[0].Progress(); !ok || progress != 0.5 {
+		t.Errorf("Expected progress 0.5, got %v", progress)
+	}
+}
+
+func TestNotificationStyleForFollowsTheme(t *testing.T) {
+	theme := NewTestTheme()
+	if style := NotificationStyleFor(theme, NotificationError); style.Border != theme.Palette.Love || style.Icon != "✗" {
+		t.Errorf("Expected errors to use Love, got %+v", style)
+	}
+	if style := NotificationStyleFor(theme, NotificationSuccess); style.Border != theme.Palette.Pine {
+		t.Errorf("Expected success to use Pine, got %+v", style)
+	}
+}
@@ -0,0 +1,225 @@
+package tui
+
+// OverlayManager keeps a stack of open overlays such as modals
+//
+// Opening an overlay pushes a focus scope: tab cycling is confined to the
+// overlay's components and keys don't reach anything underneath. Closing it,
+// with Close or the esc key, restores the focus that was active before it
+// opened. Overlays can be nested.
+type OverlayManager struct {
+	base  *FocusTree
+	stack []*overlayEntry
+}
+
+// overlayEntry is an open overlay and its focus scope
+type overlayEntry struct {
+	overlay  Component
+	scope    *FocusTree
+	previous Focusable // Focus to restore when the overlay closes
+}
+
+// NewOverlayManager creates an overlay manager with no open overlays
+func NewOverlayManager() *OverlayManager {
+	return &OverlayManager{}
+}
+
+// SetBase sets the focus tree of the UI underneath the overlays
+// It receives keys while no overlay is open, and is where focus is restored
+// when the last overlay closes.
+func (om *OverlayManager) SetBase(base *FocusTree) {
+	om.base = base
+}
+
+// Open shows an overlay and moves focus into it
+// Focus is confined to the components found under focusRoot, which is
+// usually the overlay's content; if it's nil the overlay itself is used.
+func (om *OverlayManager) Open(overlay Component, focusRoot Component) {
+	previous := om.Focused()
+	if previous != nil {
+		previous.Blur()
+	}
+
+	if shower, ok := overlay.(interface{ Show() }); ok {
+		shower.Show()
+	}
+	if focusRoot == nil {
+		focusRoot = overlay
+	}
+
+	om.stack = append(om.stack, &overlayEntry{
+		overlay:  overlay,
+		previous: previous,
+		scope:    NewFocusTree(focusRoot),
+	})
+}
+
+// Close hides the top overlay and restores the focus that was active before
+// it opened
+// Returns false if no overlay is open.
+func (om *OverlayManager) Close() bool {
+	if len(om.stack) == 0 {
+		return false
+	}
+
+	top := om.stack[len(om.stack)-1]
+	om.stack = om.stack[:len(om.stack)-1]
+
+	if focused := top.scope.Focused(); focused != nil {
+		focused.Blur()
+	}
+	if hider, ok := top.overlay.(interface{ Hide() }); ok {
+		hider.Hide()
+	}
+	om.restore(top.previous)
+	return true
+}
+
+// openPopup returns the focused component if it has a popup open, or else
+// the first component in the overlay that does, or nil
+func (e *overlayEntry) openPopup() Component {
+	if focused, ok := e.scope.Focused().(Component); ok && isOpen(focused) {
+		return focused
+	}
+	var find func(component Component) Component
+	find = func(component Component) Component {
+		if component == nil {
+			return nil
+		}
+		if visible, ok := component.(interface{ IsVisible() bool }); ok && !visible.IsVisible() {
+			return nil
+		}
+		if !sameComponent(component, e.overlay) && isOpen(component) {
+			return component
+		}
+		if composite, ok := component.(Composite); ok {
+			for _, child := range composite.Children() {
+				if found := find(child); found != nil {
+					return found
+				}
+			}
+		}
+		return nil
+	}
+	return find(e.overlay)
+}
+
+// CloseAll closes every open overlay, top first
+func (om *OverlayManager) CloseAll() {
+	for om.Close() {
+	}
+}
+
+// restore gives focus back to a component, through the scope it belongs to
+// so that scope's tab position stays in sync
+func (om *OverlayManager) restore(previous Focusable) {
+	if previous == nil {
+		return
+	}
+	if scope := om.scope(); scope != nil && scope.Focus(previous) {
+		return
+	}
+	previous.Focus()
+}
+
+// prune closes overlays that were hidden directly, for example by a Modal
+// handling esc itself
+func (om *OverlayManager) prune() {
+	for len(om.stack) > 0 {
+		top := om.stack[len(om.stack)-1]
+		visible, ok := top.overlay.(interface{ IsVisible() bool })
+		if !ok || visible.IsVisible() {
+			return
+		}
+		om.Close()
+	}
+}
+
+// scope returns the focus tree that currently receives keys, or nil
+func (om *OverlayManager) scope() *FocusTree {
+	if len(om.stack) > 0 {
+		return om.stack[len(om.stack)-1].scope
+	}
+	return om.base
+}
+
+// Scope returns the focus tree of the top overlay, or the base focus tree
+// when no overlay is open
+func (om *OverlayManager) Scope() *FocusTree {
+	om.prune()
+	return om.scope()
+}
+
+// Focused returns the focused component in the current scope, or nil
+func (om *OverlayManager) Focused() Focusable {
+	if scope := om.scope(); scope != nil {
+		return scope.Focused()
+	}
+	return nil
+}
+
+// IsOpen returns whether any overlay is open
+func (om *OverlayManager) IsOpen() bool {
+	om.prune()
+	return len(om.stack) > 0
+}
+
+// Depth returns the number of open overlays
+func (om *OverlayManager) Depth() int {
+	om.prune()
+	return len(om.stack)
+}
+
+// Top returns the top overlay, or nil
+func (om *OverlayManager) Top() Component {
+	om.prune()
+	if len(om.stack) == 0 {
+		return nil
+	}
+	return om.stack[len(om.stack)-1].overlay
+}
+
+// HandleKey routes a key to the top overlay's focus scope
+// Esc closes the top overlay, unless something in it has a popup open, like
+// a Select's options or a ContextMenu, in which case that gets the key. While
+// an overlay is open every key is considered handled, so nothing underneath
+// sees it.
+// Returns true if the key was handled
+func (om *OverlayManager) HandleKey(key string) bool {
+	om.prune()
+	if len(om.stack) == 0 {
+		if om.base != nil {
+			return om.base.HandleKey(key)
+		}
+		return false
+	}
+
+	top := om.stack[len(om.stack)-1]
+	if key == "esc" {
+		if popup := top.openPopup(); popup != nil {
+			if handler, ok := popup.(interface{ HandleKey(string) bool }); ok {
+				handler.HandleKey(key)
+			} else {
+				popup.HandleInput(key)
+			}
+			return true
+		}
+		om.Close()
+		return true
+	}
+	top.scope.HandleKey(key)
+	om.prune()
+	return true
+}
+
+// Draw renders the open overlays, bottom first
+func (om *OverlayManager) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	om.prune()
+	for _, entry := range om.stack {
+		screen.DrawComponent(entry.overlay, x, y, availableWidth, availableHeight, theme)
+	}
+}
+
+// HandleInput processes keyboard input
+func (om *OverlayManager) HandleInput(key string) {
+	om.HandleKey(key)
+}
@@ -0,0 +1,176 @@
+package tui
+
+import "testing"
+
+func TestOverlayManagerTrapsAndRestoresFocus(t *testing.T) {
+	editor := &mockComponent{id: "editor"}
+	sidebar := &mockComponent{id: "sidebar"}
+	base := HBox()
+	base.AddFlex(sidebar, 1)
+	base.AddFlex(editor, 1)
+
+	overlays := NewOverlayManager()
+	overlays.SetBase(NewFocusTree(base))
+	overlays.HandleKey("tab")
+	if !editor.focused {
+		t.Fatal("Expected tab to reach the editor while no overlay is open")
+	}
+
+	search := &mockComponent{id: "search"}
+	results := &mockComponent{id: "results"}
+	content := VBox()
+	content.AddFixed(search, 1)
+	content.AddFlex(results, 1)
+	modal := NewModal()
+
+	overlays.Open(modal, content)
+	if !modal.IsVisible() {
+		t.Error("Expected Open to show the modal")
+	}
+	if editor.focused || !search.focused {
+		t.Error("Expected focus to move from the editor into the modal")
+	}
+
+	// Tab cycles inside the modal only
+	for i, expected := range []*mockComponent{results, search, results} {
+		overlays.HandleKey("tab")
+		if !expected.focused {
+			t.Fatalf("Tab %d: expected %s to be focused", i+1, expected.id)
+		}
+		if sidebar.focused || editor.focused {
+			t.Fatalf("Tab %d: focus escaped the modal", i+1)
+		}
+	}
+
+	if !overlays.HandleKey("x") {
+		t.Error("Expected keys to be swallowed while a modal is open")
+	}
+
+	overlays.HandleKey("esc")
+	if modal.IsVisible() || overlays.IsOpen() {
+		t.Error("Expected esc to close the modal")
+	}
+	if !editor.focused || results.focused {
+		t.Error("Expected focus to return to the editor")
+	}
+
+	// The base tree's tab position was restored with it
+	overlays.HandleKey("tab")
+	if !sidebar.focused {
+		t.Error("Expected tab to continue from the editor")
+	}
+}
+
+func TestOverlayManagerNestedModals(t *testing.T) {
+	editor := &mockComponent{id: "editor"}
+	overlays := NewOverlayManager()
+	overlays.SetBase(NewFocusTree(editor))
+
+	first := &mockComponent{id: "first"}
+	second := &mockComponent{id: "second"}
+	outer := NewModal()
+	inner := NewModal()
+
+	overlays.Open(outer, first)
+	overlays.Open(inner, second)
+	if overlays.Depth() != 2 || overlays.Top() != Component(inner) {
+		t.Fatalf("Expected two overlays with inner on top, got %d", overlays.Depth())
+	}
+	if first.focused || !second.focused {
+		t.Error("Expected the inner modal to hold focus")
+	}
+
+	overlays.Close()
+	if !outer.IsVisible() || inner.IsVisible() {
+		t.Error("Expected only the inner modal to close")
+	}
+	if !first.focused || second.focused {
+		t.Error("Expected focus to return to the outer modal")
+	}
+
+	overlays.Close()
+	if !editor.focused || first.focused {
+		t.Error("Expected focus to return to the editor")
+	}
+	if overlays.Close() {
+		t.Error("Expected Close to report nothing left to close")
+	}
+}
+
+func TestOverlayManagerNoticesHiddenModal(t *testing.T) {
+	editor := &mockComponent{id: "editor"}
+	overlays := NewOverlayManager()
+	overlays.SetBase(NewFocusTree(editor))
+
+	// With no content the modal itself takes focus and closes on enter
+	modal := NewModal()
+	overlays.Open(modal, nil)
+	if !modal.IsFocused() {
+		t.Fatal("Expected the modal to be focused")
+	}
+
+	overlays.HandleKey("enter")
+	if modal.IsVisible() || overlays.IsOpen() {
+		t.Error("Expected the modal to be closed")
+	}
+	if !editor.focused || modal.IsFocused() {
+		t.Error("Expected focus to return to the editor")
+	}
+}
+
+func TestOverlayManagerEscClosesPopupsFirst(t *testing.T) {
+	editor := &mockComponent{id: "editor"}
+	overlays := NewOverlayManager()
+	overlays.SetBase(NewFocusTree(editor))
+
+	sel := NewSelect("alpha", "bravo")
+	menu := NewMenu("")
+	menu.AddItem("Copy", "copy")
+	context := NewContextMenu(menu)
+	content := VBox()
+	content.AddFixed(sel, 1)
+	content.AddFixed(context, 1)
+
+	modal := NewModal()
+	modal.SetContent(content)
+	overlays.Open(modal, content)
+
+	// The focused Select's options close first
+	sel.Open()
+	overlays.HandleKey("esc")
+	if sel.IsOpen() || !overlays.IsOpen() {
+		t.Fatal("Expected esc to close the options and keep the modal open")
+	}
+
+	// So does a context menu inside the overlay
+	context.Open(0, 0)
+	overlays.HandleKey("esc")
+	if context.IsOpen() || !overlays.IsOpen() {
+		t.Fatal("Expected esc to close the context menu and keep the modal open")
+	}
+
+	overlays.HandleKey("esc")
+	if overlays.IsOpen() {
+		t.Error("Expected esc to close the modal once nothing else is open")
+	}
+}
+
+func TestOverlayManagerDraw(t *testing.T) {
+	screen := NewScreenSimulation(20, 10)
+	screen.SetRectTracking(true)
+	overlays := NewOverlayManager()
+
+	overlays.Draw(screen.Screen, 0, 0, 20, 10, NewTestTheme())
+	if len(screen.DrawnRects()) != 0 {
+		t.Error("Expected nothing drawn without overlays")
+	}
+
+	modal := NewModal()
+	modal.SetSize(10, 4)
+	overlays.Open(modal, nil)
+	overlays.Draw(screen.Screen, 0, 0, 20, 10, NewTestTheme())
+	rects := screen.DrawnRects()
+	if len(rects) != 1 || rects[0].Component != Component(modal) {
+		t.Errorf("Expected the modal to be drawn, got %v", rects)
+	}
+}
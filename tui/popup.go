@@ -0,0 +1,78 @@
+package tui
+
+// DeferOverlay queues a drawing to happen above everything else
+//
+// Popups such as an open Select call this while the normal tree is drawn.
+// The queued functions run, in order, when DrawOverlays is called or just
+// before the root screen renders, so they land on top of siblings drawn
+// later. They receive the unclipped root screen; use OverlayOrigin to
+// translate local coordinates. Clear drops anything still queued.
+func (s *Screen) DeferOverlay(draw func(screen *Screen)) {
+	queue := s.overlayQueue()
+	queue.overlays = append(queue.overlays, draw)
+}
+
+// overlayQueue returns the root screen that DeferOverlay queues drawings on
+func (s *Screen) overlayQueue() *Screen {
+	root := s.rootScreen()
+	if root.overlayTarget != nil {
+		return root.overlayTarget.overlayQueue()
+	}
+	return root
+}
+
+// OverlayOrigin returns the position of this view's (0, 0) on the screen
+// that overlays are drawn on
+// It matches Origin, except on offscreen screens such as a ScrollView's,
+// whose overlays are drawn on the screen the view is shown on.
+func (s *Screen) OverlayOrigin() (x, y int) {
+	x, y = s.Origin()
+	root := s.rootScreen()
+	if root.overlayTarget != nil {
+		targetX, targetY := root.overlayTarget.OverlayOrigin()
+		x, y = x+targetX+root.overlayX, y+targetY+root.overlayY
+	}
+	return x, y
+}
+
+// setOverlayTarget makes an offscreen screen queue its overlays on another
+// screen, where its (0, 0) is shown at x, y
+func (s *Screen) setOverlayTarget(target *Screen, x, y int) {
+	root := s.rootScreen()
+	root.overlayTarget, root.overlayX, root.overlayY = target, x, y
+}
+
+// DrawOverlays runs the drawings queued with DeferOverlay
+func (s *Screen) DrawOverlays() {
+	root := s.rootScreen()
+	// Overlays may queue more overlays, like a submenu
+	for len(root.overlays) > 0 {
+		draw := root.overlays[0]
+		root.overlays = root.overlays[1:]
+		draw(root)
+	}
+}
+
+// PlacePopup positions a popup of the given size next to an anchor so it
+// stays inside bounds
+// The popup opens below the anchor, or above it when there isn't room
+// below. When it fits neither way it goes on the roomier side and is cut
+// short. It is aligned with the anchor's left edge, shifted left as far as
+// needed to stay inside.
+func PlacePopup(anchor Rectangle, width, height int, bounds Rectangle) Rectangle {
+	width = min(width, bounds.Width)
+	x := min(anchor.X, bounds.X+bounds.Width-width)
+	x = max(x, bounds.X)
+
+	below := bounds.Y + bounds.Height - (anchor.Y + anchor.Height)
+	above := anchor.Y - bounds.Y
+	switch {
+	case height <= below:
+		return Rectangle{X: x, Y: anchor.Y + anchor.Height, Width: width, Height: height}
+	case height <= above:
+		return Rectangle{X: x, Y: anchor.Y - height, Width: width, Height: height}
+	case below >= above:
+		return Rectangle{X: x, Y: anchor.Y + anchor.Height, Width: width, Height: max(0, below)}
+	}
+	return Rectangle{X: x, Y: bounds.Y, Width: width, Height: above}
+}
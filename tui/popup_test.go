@@ -0,0 +1,55 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestPlacePopup(t *testing.T) {
+	bounds := Rectangle{Width: 40, Height: 20}
+	tests := []struct {
+		name     string
+		anchor   Rectangle
+		width    int
+		height   int
+		expected Rectangle
+	}{
+		{"below", Rectangle{X: 5, Y: 2, Width: 10, Height: 1}, 12, 6, Rectangle{X: 5, Y: 3, Width: 12, Height: 6}},
+		{"above when no room below", Rectangle{X: 5, Y: 17, Width: 10, Height: 1}, 12, 6, Rectangle{X: 5, Y: 11, Width: 12, Height: 6}},
+		{"shifted left at the edge", Rectangle{X: 35, Y: 2, Width: 5, Height: 1}, 12, 6, Rectangle{X: 28, Y: 3, Width: 12, Height: 6}},
+		{"cut short on the roomier side", Rectangle{X: 0, Y: 12, Width: 5, Height: 1}, 8, 30, Rectangle{X: 0, Y: 0, Width: 8, Height: 12}},
+		{"no wider than the bounds", Rectangle{X: 0, Y: 0, Width: 5, Height: 1}, 50, 2, Rectangle{X: 0, Y: 1, Width: 40, Height: 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PlacePopup(tt.anchor, tt.width, tt.height, bounds); got != tt.expected {
+				t.Errorf("Expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDeferOverlayDrawsAboveLaterSiblings(t *testing.T) {
+	sim := NewScreenSimulation(20, 3)
+	style := lipgloss.NewStyle()
+
+	// A clipped child queues an overlay outside its own area
+	child := sim.Screen.Sub(0, 0, 5, 1)
+	child.DeferOverlay(func(root *Screen) {
+		root.DrawString(0, 1, "popup", style)
+	})
+	// A sibling drawn afterwards covers the same spot
+	sim.Screen.DrawString(0, 1, "under", style)
+
+	sim.Screen.Render()
+	AssertTextExists(t, sim, "popup")
+	AssertTextNotExists(t, sim, "under")
+
+	sim.Screen.DeferOverlay(func(root *Screen) {
+		root.DrawString(0, 2, "stale", style)
+	})
+	sim.Screen.Clear()
+	sim.Screen.Render()
+	AssertTextNotExists(t, sim, "stale")
+}
@@ -0,0 +1,306 @@
+package tui
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ProgressBarInterval is how often an indeterminate progress bar moves one
+// cell
+const ProgressBarInterval = 60 * time.Millisecond
+
+// eighthBlocks are the partial blocks for 1/8 to 7/8 of a cell, filled from
+// the left
+var eighthBlocks = []rune{'▏', '▎', '▍', '▌', '▋', '▊', '▉'}
+
+// drawBar draws a horizontal bar filled to a fraction with eighth-of-a-cell
+// precision over a track in the overlay color
+func drawBar(screen *Screen, x, y, width int, fraction float64, fill lipgloss.TerminalColor, theme *Theme) {
+	if width <= 0 {
+		return
+	}
+	track := lipgloss.NewStyle().Background(theme.Palette.Overlay)
+	screen.DrawString(x, y, strings.Repeat(" ", width), track)
+	drawFill(screen, x, y, width, fraction, track.Foreground(fill))
+}
+
+// drawFill draws the filled part of a horizontal bar with eighth-of-a-cell
+// precision, leaving the rest untouched
+func drawFill(screen *Screen, x, y, width int, fraction float64, style lipgloss.Style) {
+	fraction = math.Max(0, math.Min(1, fraction))
+	eighths := int(math.Round(fraction * float64(width*8)))
+	for i := 0; i < eighths/8; i++ {
+		screen.DrawRune(x+i, y, '█', style)
+	}
+	if rest := eighths % 8; rest > 0 {
+		screen.DrawRune(x+eighths/8, y, eighthBlocks[rest-1], style)
+	}
+}
+
+// ProgressBar shows how far along an operation is
+//
+// A determinate bar fills with eighth-of-a-cell precision and can show the
+// percentage after it. An indeterminate bar, for work of unknown length,
+// sweeps a block back and forth instead, driven through the Timed interface
+// like Spinner.
+type ProgressBar struct {
+	progress      float64
+	indeterminate bool
+	showPercent   bool
+	width         int
+	role          ColorRole
+	start         time.Time
+	timer         *Timer
+}
+
+// NewProgressBar creates an empty determinate progress bar
+func NewProgressBar() *ProgressBar {
+	return &ProgressBar{
+		showPercent: true,
+		width:       20,
+		role:        RolePrimary,
+		timer:       NewTimer(nil),
+	}
+}
+
+// SetProgress sets how far along the operation is, from 0 to 1
+func (p *ProgressBar) SetProgress(progress float64) {
+	p.progress = math.Max(0, math.Min(1, progress))
+}
+
+// Progress returns how far along the operation is, from 0 to 1
+func (p *ProgressBar) Progress() float64 {
+	return p.progress
+}
+
+// SetIndeterminate switches between showing progress and sweeping for work
+// of unknown length
+// Switching to indeterminate restarts the sweep; use TimerCmd to animate it.
+func (p *ProgressBar) SetIndeterminate(indeterminate bool) {
+	p.indeterminate = indeterminate
+	p.start = p.timer.Clock().Now()
+	if !indeterminate {
+		p.timer.Stop()
+	}
+}
+
+// IsIndeterminate returns whether the bar sweeps instead of showing progress
+func (p *ProgressBar) IsIndeterminate() bool {
+	return p.indeterminate
+}
+
+// SetShowPercent sets whether the percentage is shown after a determinate
+// bar
+func (p *ProgressBar) SetShowPercent(show bool) {
+	p.showPercent = show
+}
+
+// SetWidth sets the preferred width reported by Measure
+func (p *ProgressBar) SetWidth(width int) {
+	p.width = width
+}
+
+// SetColor sets the palette role the bar is filled with
+func (p *ProgressBar) SetColor(role ColorRole) {
+	p.role = role
+}
+
+// SetClock sets the clock used for the sweep
+func (p *ProgressBar) SetClock(clock Clock) {
+	p.timer.SetClock(clock)
+	p.start = p.timer.Clock().Now()
+}
+
+// TimerCmd returns a command that fires when an indeterminate bar next moves
+func (p *ProgressBar) TimerCmd() tea.Cmd {
+	if !p.indeterminate {
+		p.timer.Stop()
+		return nil
+	}
+	step := p.timer.Clock().Now().Sub(p.start) / ProgressBarInterval
+	return p.timer.Schedule(p.start.Add((step + 1) * ProgressBarInterval))
+}
+
+// HandleTimer schedules the next move when the sweep timer fires
+// Returns nil if msg isn't for this bar.
+func (p *ProgressBar) HandleTimer(msg TimerMsg) tea.Cmd {
+	if !p.timer.Fired(msg) {
+		return nil
+	}
+	return p.TimerCmd()
+}
+
+// percentText returns the percentage shown after a determinate bar
+func (p *ProgressBar) percentText() string {
+	if p.indeterminate || !p.showPercent {
+		return ""
+	}
+	return fmt.Sprintf(" %3d%%", int(math.Round(p.progress*100)))
+}
+
+// Measure returns the preferred width and one row
+func (p *ProgressBar) Measure(maxWidth, maxHeight int) (width, height int) {
+	return min(p.width, maxWidth), min(1, maxHeight)
+}
+
+// Draw renders the bar, and the percentage when determinate
+func (p *ProgressBar) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	if availableWidth <= 0 || availableHeight <= 0 {
+		return
+	}
+	percent := p.percentText()
+	barWidth := availableWidth - StringWidth(percent)
+	if barWidth < 1 {
+		percent, barWidth = "", availableWidth
+	}
+	fill := p.role.Color(theme.Palette)
+
+	if !p.indeterminate {
+		drawBar(screen, x, y, barWidth, p.progress, fill, theme)
+		screen.DrawString(x+barWidth, y, percent, lipgloss.NewStyle().Foreground(theme.Palette.TextMuted))
+		return
+	}
+
+	// A block a quarter of the bar wide bounces between the ends
+	block := max(1, barWidth/4)
+	travel := barWidth - block
+	pos := 0
+	if travel > 0 {
+		step := int(p.timer.Clock().Now().Sub(p.start) / ProgressBarInterval)
+		pos = step % (2 * travel)
+		if pos > travel {
+			pos = 2*travel - pos
+		}
+	}
+	screen.DrawString(x, y, strings.Repeat(" ", barWidth), lipgloss.NewStyle().Background(theme.Palette.Overlay))
+	screen.DrawString(x+pos, y, strings.Repeat("█", block), lipgloss.NewStyle().Foreground(fill).Background(theme.Palette.Overlay))
+}
+
+// HandleInput does nothing; progress bars don't take input
+func (p *ProgressBar) HandleInput(key string) {}
+
+// GaugeThreshold colors a Gauge once its value reaches From
+type GaugeThreshold struct {
+	From float64
+	Role ColorRole
+}
+
+// Gauge shows a value within a range as a labeled bar, colored by the
+// highest threshold the value has reached
+type Gauge struct {
+	label      string
+	value      float64
+	min, max   float64
+	thresholds []GaugeThreshold
+	format     func(value, fraction float64) string
+	width      int
+}
+
+// NewGauge creates a gauge over the range 0 to 100
+func NewGauge(label string) *Gauge {
+	return &Gauge{
+		label: label,
+		max:   100,
+		width: 30,
+	}
+}
+
+// SetLabel sets the text shown before the bar
+func (g *Gauge) SetLabel(label string) {
+	g.label = label
+}
+
+// SetRange sets the values at the empty and full ends of the bar
+func (g *Gauge) SetRange(low, high float64) {
+	g.min, g.max = low, high
+}
+
+// SetValue sets the value shown
+func (g *Gauge) SetValue(value float64) {
+	g.value = value
+}
+
+// Value returns the value shown
+func (g *Gauge) Value() float64 {
+	return g.value
+}
+
+// Fraction returns how full the bar is, from 0 to 1
+func (g *Gauge) Fraction() float64 {
+	if g.max <= g.min {
+		return 0
+	}
+	return math.Max(0, math.Min(1, (g.value-g.min)/(g.max-g.min)))
+}
+
+// SetThresholds sets the colors for ranges of values, such as Pine from 0,
+// Gold from 70 and Love from 90
+// Below the lowest threshold the bar uses the primary color.
+func (g *Gauge) SetThresholds(thresholds ...GaugeThreshold) {
+	g.thresholds = thresholds
+}
+
+// Role returns the color role for the current value
+func (g *Gauge) Role() ColorRole {
+	role, reached := RolePrimary, math.Inf(-1)
+	for _, threshold := range g.thresholds {
+		if g.value >= threshold.From && threshold.From >= reached {
+			role, reached = threshold.Role, threshold.From
+		}
+	}
+	return role
+}
+
+// SetFormat sets how the value is written after the bar; the percentage of
+// the range by default
+func (g *Gauge) SetFormat(format func(value, fraction float64) string) {
+	g.format = format
+}
+
+// SetWidth sets the preferred width reported by Measure
+func (g *Gauge) SetWidth(width int) {
+	g.width = width
+}
+
+// valueText returns the value as written after the bar
+func (g *Gauge) valueText() string {
+	if g.format != nil {
+		return g.format(g.value, g.Fraction())
+	}
+	return fmt.Sprintf("%d%%", int(math.Round(g.Fraction()*100)))
+}
+
+// Measure returns the preferred width and one row
+func (g *Gauge) Measure(maxWidth, maxHeight int) (width, height int) {
+	return min(g.width, maxWidth), min(1, maxHeight)
+}
+
+// Draw renders the label, the bar and the value, like "CPU ███▌   42%"
+func (g *Gauge) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	if availableWidth <= 0 || availableHeight <= 0 {
+		return
+	}
+	color := g.Role().Color(theme.Palette)
+	col, right := x, x+availableWidth
+
+	if g.label != "" {
+		label := Truncate(g.label, availableWidth)
+		screen.DrawString(col, y, label, lipgloss.NewStyle().Foreground(theme.Palette.Text))
+		col += StringWidth(label) + 1
+	}
+	value := g.valueText()
+	valueWidth := StringWidth(value)
+	if col+valueWidth+2 <= right {
+		right -= valueWidth + 1
+		screen.DrawString(right+1, y, value, lipgloss.NewStyle().Foreground(color).Bold(true))
+	}
+	drawBar(screen, col, y, right-col, g.Fraction(), color, theme)
+}
+
+// HandleInput does nothing; gauges don't take input
+func (g *Gauge) HandleInput(key string) {}
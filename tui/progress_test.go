@@ -0,0 +1,148 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestProgressBarEighths(t *testing.T) {
+	bar := NewProgressBar()
+	bar.SetShowPercent(false)
+	theme := NewTestTheme()
+
+	tests := []struct {
+		progress float64
+		expected string
+	}{
+		{0, ""},
+		{0.5, "██"},
+		{0.5 + 1.0/32, "██▏"}, // One eighth of a cell more
+		{0.5 + 3.0/32, "██▍"},
+		{1, "████"},
+		{2, "████"}, // Clamped
+	}
+	for _, tt := range tests {
+		bar.SetProgress(tt.progress)
+		sim := NewScreenSimulation(4, 1)
+		bar.Draw(sim.Screen, 0, 0, 4, 1, theme)
+		if line := sim.GetLine(0); line != tt.expected {
+			t.Errorf("Progress %v: expected %q, got %q", tt.progress, tt.expected, line)
+		}
+	}
+}
+
+func TestProgressBarPercent(t *testing.T) {
+	bar := NewProgressBar()
+	bar.SetProgress(0.42)
+
+	sim := NewScreenSimulation(15, 1)
+	bar.Draw(sim.Screen, 0, 0, 15, 1, NewTestTheme())
+	if line := sim.GetLine(0); !strings.HasSuffix(line, "  42%") {
+		t.Errorf("Expected the percentage at the end, got %q", line)
+	}
+}
+
+func TestProgressBarIndeterminateSweeps(t *testing.T) {
+	clock := NewFakeClock(testEpoch)
+	bar := NewProgressBar()
+	bar.SetClock(clock)
+	if bar.TimerCmd() != nil {
+		t.Error("Expected a determinate bar not to animate")
+	}
+	bar.SetIndeterminate(true)
+	theme := NewTestTheme()
+
+	draw := func() string {
+		sim := NewScreenSimulation(8, 1)
+		bar.Draw(sim.Screen, 0, 0, 8, 1, theme)
+		return sim.GetLine(0)
+	}
+
+	if line := draw(); line != "██" {
+		t.Errorf("Expected the block at the start, got %q", line)
+	}
+	msg := runTimerCmd(t, clock, bar.TimerCmd(), ProgressBarInterval)
+	if bar.HandleTimer(msg) == nil {
+		t.Error("Expected the next move to be scheduled")
+	}
+	if line := draw(); line != " ██" {
+		t.Errorf("Expected the block one cell on, got %q", line)
+	}
+	// The block bounces back from the end: travel is 6 cells
+	clock.Advance(7 * ProgressBarInterval)
+	if line := draw(); line != "    ██" {
+		t.Errorf("Expected the block on its way back, got %q", line)
+	}
+}
+
+func TestGaugeThresholds(t *testing.T) {
+	gauge := NewGauge("CPU")
+	gauge.SetThresholds(
+		GaugeThreshold{From: 90, Role: RoleLove},
+		GaugeThreshold{From: 0, Role: RolePine},
+		GaugeThreshold{From: 70, Role: RoleGold},
+	)
+
+	tests := []struct {
+		value    float64
+		expected ColorRole
+	}{
+		{-5, RolePrimary},
+		{10, RolePine},
+		{70, RoleGold},
+		{95, RoleLove},
+	}
+	for _, tt := range tests {
+		gauge.SetValue(tt.value)
+		if role := gauge.Role(); role != tt.expected {
+			t.Errorf("Value %v: expected %s, got %s", tt.value, tt.expected, role)
+		}
+	}
+}
+
+func TestGaugeDraw(t *testing.T) {
+	theme := NewTestTheme()
+	gauge := NewGauge("Mem")
+	gauge.SetRange(0, 8)
+	gauge.SetValue(2)
+
+	sim := NewScreenSimulation(16, 1)
+	gauge.Draw(sim.Screen, 0, 0, 16, 1, theme)
+	// "Mem " + 8 cell bar + " 25%"
+	if line := sim.GetLine(0); line != "Mem ██       25%" {
+		t.Errorf("Unexpected gauge %q", line)
+	}
+
+	gauge.SetValue(8)
+	gauge.SetThresholds(GaugeThreshold{From: 6, Role: RoleLove})
+	sim = NewScreenSimulation(16, 1)
+	gauge.Draw(sim.Screen, 0, 0, 16, 1, theme)
+	cell, _ := sim.Screen.cellAt(4, 0)
+	if cell.Foreground != theme.Palette.Love {
+		t.Errorf("Expected the bar in the love color, got %v", cell.Foreground)
+	}
+}
+
+func TestWidgetsEmbedInBordersAndStatusBars(t *testing.T) {
+	theme := NewTestTheme()
+	bar := NewProgressBar()
+	bar.SetShowPercent(false)
+	bar.SetProgress(1)
+
+	container := NewContainer()
+	container.AddBorderElement(NewComponentElement(bar, 4), BorderTop, BorderAlignRight)
+	sim := NewScreenSimulation(20, 3)
+	container.Draw(sim.Screen, 0, 0, 20, 3, theme)
+	AssertTextExists(t, sim, "████")
+
+	spinner := NewSpinner()
+	spinner.SetLabel("idle")
+	status := NewStatusBar()
+	status.AddSegment("left", "left")
+	status.AddElement(NewComponentElement(spinner, 0), "right")
+	sim = NewScreenSimulation(20, 1)
+	status.Draw(sim.Screen, 0, 0, 20, 1, theme)
+	if line := sim.GetLine(0); !strings.HasPrefix(line, "left") || !strings.HasSuffix(line, " idle") {
+		t.Errorf("Expected the spinner at the right, got %q", line)
+	}
+}
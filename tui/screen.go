@@ -5,11 +5,35 @@ import (
 	"strings"
 )
 
+// Screen is a grid of cells that components draw into. A Screen can also be
+// a clipped view of another screen (see Sub), in which case coordinates are
+// local to the view and drawing outside it is silently ignored.
 type Screen struct {
-	width  int
-	height int
-	cells  [][]Cell
-	theme  Theme
+	width      int
+	height     int
+	cells      [][]Cell
+	theme      Theme
+	originX    int       // Backing position of local (0, 0)
+	originY    int       // Backing position of local (0, 0)
+	clip       Rectangle // Drawable area in backing coordinates
+	root       *Screen   // Screen owning the cells (nil for the root itself)
+	trackRects bool
+	rects      []ComponentRect
+	drawStack  []int                  // Indices of the records currently being drawn
+	overlays   []func(screen *Screen) // Drawings queued with DeferOverlay
+	// Offscreen screens hand their overlays to the screen they're shown on,
+	// with their (0, 0) at overlayX, overlayY there
+	overlayTarget *Screen
+	overlayX      int
+	overlayY      int
+}
+
+// ComponentRect pairs a component with the rectangle it was drawn into
+type ComponentRect struct {
+	Component  Component
+	Rect       Rectangle
+	Constraint string // Description of the constraint that sized it, if any
+	Parent     int    // Index of the enclosing record, or -1 at the top level
 }
 
 // newThemedCell creates a cell with the theme's background color
@@ -25,6 +49,7 @@ func NewScreen(width, height int, theme Theme) *Screen {
 		width:  width,
 		height: height,
 		theme:  theme,
+		clip:   Rectangle{X: 0, Y: 0, Width: width, Height: height},
 	}
 	s.cells = make([][]Cell, height)
 	for i := range s.cells {
@@ -39,54 +64,134 @@ func NewDefaultScreen(width, height int) *Screen {
 	return NewScreen(width, height, DefaultTheme)
 }
 
+// Sub returns a clipped view of the screen covering the given rectangle.
+// The view uses local coordinates with (0, 0) at the rectangle's top-left
+// corner, and drawing outside the rectangle (or outside this screen's own
+// clip area) is silently ignored. Views share cells with their parent.
+func (s *Screen) Sub(x, y, width, height int) *Screen {
+	if width < 0 {
+		width = 0
+	}
+	if height < 0 {
+		height = 0
+	}
+
+	originX := s.originX + x
+	originY := s.originY + y
+	bounds := Rectangle{X: originX, Y: originY, Width: width, Height: height}
+
+	return &Screen{
+		width:   width,
+		height:  height,
+		cells:   s.cells,
+		theme:   s.theme,
+		originX: originX,
+		originY: originY,
+		clip:    s.clip.Intersect(bounds),
+		root:    s.rootScreen(),
+	}
+}
+
+// IsClipped returns whether this screen is a clipped view of another screen
+func (s *Screen) IsClipped() bool {
+	return s.root != nil
+}
+
+// Origin returns the position of this view's (0, 0) on the root screen
+func (s *Screen) Origin() (x, y int) {
+	return s.originX, s.originY
+}
+
+// rootScreen returns the screen that owns the cells
+func (s *Screen) rootScreen() *Screen {
+	if s.root != nil {
+		return s.root
+	}
+	return s
+}
+
+// toBacking translates local coordinates into cell coordinates and reports
+// whether the position is drawable
+func (s *Screen) toBacking(x, y int) (int, int, bool) {
+	if x < 0 || x >= s.width || y < 0 || y >= s.height {
+		return 0, 0, false
+	}
+	bx, by := s.originX+x, s.originY+y
+	return bx, by, s.clip.Contains(bx, by)
+}
+
 func (s *Screen) SetCell(x, y int, cell Cell) {
-	if x >= 0 && x < s.width && y >= 0 && y < s.height {
-		// Check if we're overwriting a continuation cell
-		if x > 0 && s.cells[y][x].IsContinuation() {
-			// Find the start of the wide character and clear it
-			for i := x - 1; i >= 0 && i > x-3; i-- {
-				if !s.cells[y][i].IsContinuation() {
-					// Found the wide character, clear it with theme background
-					s.cells[y][i] = s.newThemedCell(' ')
-					break
+	bx, by, ok := s.toBacking(x, y)
+	if !ok {
+		return
+	}
+	row := s.cells[by]
+	clipRight := s.clip.X + s.clip.Width
+
+	// A wide character that would be cut by the clip edge becomes a blank
+	if bx+cell.Width > clipRight {
+		background := cell.Background
+		cell = s.newThemedCell(' ')
+		cell.Background = background
+	}
+
+	// Check if we're overwriting a continuation cell
+	if bx > 0 && row[bx].IsContinuation() {
+		// Find the start of the wide character and clear it
+		for i := bx - 1; i >= 0 && i > bx-3; i-- {
+			if !row[i].IsContinuation() {
+				// Found the wide character, clear it with theme background,
+				// unless it belongs to the area outside the clip
+				if s.clip.Contains(i, by) {
+					row[i] = s.newThemedCell(' ')
 				}
+				break
 			}
 		}
+	}
 
-		// Check if we're overwriting the start of a wide character
-		if x+1 < s.width && s.cells[y][x+1].IsContinuation() {
-			// Clear continuation cells with theme background
-			for i := x + 1; i < s.width && s.cells[y][i].IsContinuation(); i++ {
-				s.cells[y][i] = s.newThemedCell(' ')
-			}
+	// Check if we're overwriting the start of a wide character
+	if bx+1 < len(row) && row[bx+1].IsContinuation() {
+		// Clear continuation cells with theme background
+		for i := bx + 1; i < len(row) && row[i].IsContinuation() && s.clip.Contains(i, by); i++ {
+			row[i] = s.newThemedCell(' ')
 		}
+	}
 
-		s.cells[y][x] = cell
+	row[bx] = cell
 
-		// For wide characters, set continuation cells
-		if cell.Width > 1 {
-			continuationCell := NewContinuationCell()
-			continuationCell.Foreground = cell.Foreground
-			continuationCell.Background = cell.Background
-			continuationCell.Bold = cell.Bold
-			continuationCell.Italic = cell.Italic
-			continuationCell.Underline = cell.Underline
-			continuationCell.Dim = cell.Dim
+	// For wide characters, set continuation cells
+	if cell.Width > 1 {
+		continuationCell := NewContinuationCell()
+		continuationCell.Foreground = cell.Foreground
+		continuationCell.Background = cell.Background
+		continuationCell.Bold = cell.Bold
+		continuationCell.Italic = cell.Italic
+		continuationCell.Underline = cell.Underline
+		continuationCell.Dim = cell.Dim
 
-			for i := 1; i < cell.Width && x+i < s.width; i++ {
-				s.cells[y][x+i] = continuationCell
-			}
+		for i := 1; i < cell.Width && bx+i < clipRight; i++ {
+			row[bx+i] = continuationCell
 		}
 	}
 }
 
+// cellAt returns the cell at local coordinates and whether it is visible
+func (s *Screen) cellAt(x, y int) (Cell, bool) {
+	bx, by, ok := s.toBacking(x, y)
+	if !ok {
+		return Cell{}, false
+	}
+	return s.cells[by][bx], true
+}
+
 func (s *Screen) DrawRune(x, y int, r rune, style lipgloss.Style) {
-	if x < 0 || x >= s.width || y < 0 || y >= s.height {
-		return // Bounds check - silently ignore out-of-bounds draws
+	existingCell, ok := s.cellAt(x, y)
+	if !ok {
+		return // Bounds check - silently ignore out-of-bounds and clipped draws
 	}
 	newCell := NewCell(r).WithStyle(style)
 	// Merge with existing cell to preserve background if needed
-	existingCell := s.cells[y][x]
 	mergedCell := existingCell.Merge(newCell)
 	s.SetCell(x, y, mergedCell)
 }
@@ -105,9 +210,16 @@ func (s *Screen) DrawString(x, y int, str string, style lipgloss.Style) {
 	}
 }
 
+// Clear fills the screen (or the visible part of a view) with the theme
+// background. Clearing the root screen also resets recorded rectangles.
 func (s *Screen) Clear() {
-	for y := 0; y < s.height; y++ {
-		for x := 0; x < s.width; x++ {
+	if s.root == nil {
+		s.rects = s.rects[:0]
+		s.drawStack = s.drawStack[:0]
+		s.overlays = nil
+	}
+	for y := s.clip.Y; y < s.clip.Y+s.clip.Height; y++ {
+		for x := s.clip.X; x < s.clip.X+s.clip.Width; x++ {
 			s.cells[y][x] = s.newThemedCell(' ')
 		}
 	}
@@ -155,11 +267,9 @@ func (s *Screen) DrawBox(x, y, width, height int, style lipgloss.Style) {
 func (s *Screen) DimArea(x, y, width, height int) {
 	for dy := 0; dy < height; dy++ {
 		for dx := 0; dx < width; dx++ {
-			cellX := x + dx
-			cellY := y + dy
-			if cellX >= 0 && cellX < s.width && cellY >= 0 && cellY < s.height {
+			if bx, by, ok := s.toBacking(x+dx, y+dy); ok {
 				// Apply dimming by setting the Dim flag
-				s.cells[cellY][cellX].Dim = true
+				s.cells[by][bx].Dim = true
 			}
 		}
 	}
@@ -217,14 +327,18 @@ func (s *Screen) DrawBlockShadow(x, y, width, height int, shadowStyle lipgloss.S
 }
 
 func (s *Screen) Render() string {
+	if s.root == nil {
+		s.DrawOverlays()
+	}
+
 	var builder strings.Builder
-	builder.Grow(s.width * s.height * 2) // Pre-allocate space
+	builder.Grow(s.clip.Width * s.clip.Height * 2) // Pre-allocate space
 
-	for y := 0; y < s.height; y++ {
-		for x := 0; x < s.width; x++ {
+	for y := s.clip.Y; y < s.clip.Y+s.clip.Height; y++ {
+		for x := s.clip.X; x < s.clip.X+s.clip.Width; x++ {
 			builder.WriteString(s.cells[y][x].Render())
 		}
-		if y < s.height-1 {
+		if y < s.clip.Y+s.clip.Height-1 {
 			builder.WriteByte('\n')
 		}
 	}
@@ -235,10 +349,75 @@ func (s *Screen) Render() string {
 func (s *Screen) DrawRegion(x, y int, src *Screen, srcX, srcY, width, height int) {
 	for dy := 0; dy < height; dy++ {
 		for dx := 0; dx < width; dx++ {
-			if srcY+dy < src.height && srcX+dx < src.width {
-				cell := src.cells[srcY+dy][srcX+dx]
+			if cell, ok := src.cellAt(srcX+dx, srcY+dy); ok {
+				// Continuation cells are written along with their wide character
+				if dx > 0 && cell.IsContinuation() {
+					continue
+				}
+				// Wide characters cut by the region edge become blanks so the
+				// row keeps its width
+				if cell.IsContinuation() || dx+cell.Width > width {
+					background := cell.Background
+					cell = NewCell(' ')
+					cell.Background = background
+				}
 				s.SetCell(x+dx, y+dy, cell)
 			}
 		}
 	}
 }
+
+// SetRectTracking enables or disables recording of component rectangles.
+// When enabled, every component drawn through DrawComponent is recorded
+// until the next Clear.
+func (s *Screen) SetRectTracking(enabled bool) {
+	root := s.rootScreen()
+	root.trackRects = enabled
+	if !enabled {
+		root.rects = nil
+	}
+}
+
+// DrawnRects returns the component rectangles recorded since the last Clear,
+// in draw order (parents before their children). Rectangles are in root
+// screen coordinates.
+func (s *Screen) DrawnRects() []ComponentRect {
+	return s.rootScreen().rects
+}
+
+// DrawComponent draws a child component into the given rectangle through a
+// clipped view, so the child cannot draw over its siblings. The child sees
+// local coordinates starting at (0, 0). Layouts use this to draw their
+// children.
+func (s *Screen) DrawComponent(component Component, x, y, width, height int, theme *Theme) {
+	s.DrawConstrainedComponent(component, "", x, y, width, height, theme)
+}
+
+// DrawConstrainedComponent is like DrawComponent, and also records a
+// description of the constraint that produced the rectangle
+func (s *Screen) DrawConstrainedComponent(component Component, constraint string, x, y, width, height int, theme *Theme) {
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	root := s.rootScreen()
+	if !root.trackRects {
+		component.Draw(s.Sub(x, y, width, height), 0, 0, width, height, theme)
+		return
+	}
+
+	parent := -1
+	if len(root.drawStack) > 0 {
+		parent = root.drawStack[len(root.drawStack)-1]
+	}
+	root.rects = append(root.rects, ComponentRect{
+		Component:  component,
+		Rect:       Rectangle{X: s.originX + x, Y: s.originY + y, Width: width, Height: height},
+		Constraint: constraint,
+		Parent:     parent,
+	})
+
+	root.drawStack = append(root.drawStack, len(root.rects)-1)
+	component.Draw(s.Sub(x, y, width, height), 0, 0, width, height, theme)
+	root.drawStack = root.drawStack[:len(root.drawStack)-1]
+}
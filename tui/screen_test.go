@@ -269,3 +269,114 @@ func TestScreenSimulationFeatures(t *testing.T) {
 		t.Errorf("Expected 3 occurrences of 'l', got %d", count)
 	}
 }
+
+func TestScreenSubUsesLocalCoordinates(t *testing.T) {
+	screen := NewScreenSimulation(20, 5)
+	style := lipgloss.NewStyle()
+
+	sub := screen.Sub(5, 2, 6, 2)
+	if sub.Width() != 6 || sub.Height() != 2 {
+		t.Errorf("Expected sub size 6x2, got %dx%d", sub.Width(), sub.Height())
+	}
+
+	sub.DrawString(0, 0, "Hi", style)
+	AssertCellRune(t, screen, 5, 2, 'H')
+	AssertCellRune(t, screen, 6, 2, 'i')
+}
+
+func TestScreenSubClipsDrawing(t *testing.T) {
+	screen := NewScreenSimulation(20, 5)
+	style := lipgloss.NewStyle()
+
+	sub := screen.Sub(2, 1, 4, 2)
+	sub.DrawString(0, 0, "overflowing", style)
+	sub.DrawRune(-1, 0, 'X', style)
+	sub.DrawRune(0, 2, 'Y', style)
+
+	AssertLineContent(t, screen, 1, "  over")
+	AssertLineContent(t, screen, 0, "")
+	AssertLineContent(t, screen, 3, "")
+}
+
+func TestScreenNestedSubClipping(t *testing.T) {
+	screen := NewScreenSimulation(20, 5)
+	style := lipgloss.NewStyle()
+
+	outer := screen.Sub(2, 0, 6, 3)
+	// The inner view extends past the outer one; the overlap is drawable
+	inner := outer.Sub(3, 1, 10, 10)
+	inner.DrawString(0, 0, "abcdefgh", style)
+
+	AssertLineContent(t, screen, 1, "     abc")
+
+	// Rows outside the outer view are clipped
+	inner.DrawString(0, 5, "zzz", style)
+	if screen.CountOccurrences('z') != 0 {
+		t.Error("Expected nested view to clip to the outer view")
+	}
+
+	// Views entirely outside their parent draw nothing
+	outside := outer.Sub(10, 0, 3, 1)
+	outside.DrawString(0, 0, "qqq", style)
+	if screen.CountOccurrences('q') != 0 {
+		t.Error("Expected view outside its parent to draw nothing")
+	}
+}
+
+func TestScreenSubWideCharacterAtEdge(t *testing.T) {
+	screen := NewScreenSimulation(10, 2)
+	style := lipgloss.NewStyle()
+
+	sub := screen.Sub(0, 0, 3, 1)
+	sub.DrawString(0, 0, "a你好", style)
+
+	// "好" would straddle the clip edge, so it is blanked
+	AssertCellRune(t, screen, 1, 0, '你')
+	AssertCellRune(t, screen, 3, 0, ' ')
+	AssertCellWidth(t, screen, 3, 0, 1)
+}
+
+func TestScreenSubLeavesWideCharactersOutsideAlone(t *testing.T) {
+	screen := NewScreenSimulation(10, 1)
+	style := lipgloss.NewStyle()
+	screen.DrawString(0, 0, "你好", style)
+
+	// Drawing over half of each wide character from a view that only
+	// covers the other half must not clear the half outside it
+	screen.Sub(1, 0, 2, 1).DrawString(0, 0, "xy", style)
+
+	AssertCellRune(t, screen, 0, 0, '你')
+	AssertCellRune(t, screen, 1, 0, 'x')
+	AssertCellRune(t, screen, 2, 0, 'y')
+	if cell, _ := screen.Screen.cellAt(3, 0); !cell.IsContinuation() {
+		t.Error("Expected the continuation outside the view to stay")
+	}
+}
+
+func TestScreenDrawComponentClipsChildren(t *testing.T) {
+	screen := NewScreenSimulation(20, 3)
+	theme := NewTestTheme()
+
+	layout := HBox()
+	layout.AddFixed(NewTestComponent("LeftOverflowing", 5, 1), 5)
+	layout.AddFixed(NewTestComponent("Right", 5, 1), 5)
+	layout.Draw(screen.Screen, 0, 0, 20, 3, theme)
+
+	AssertLineContent(t, screen, 0, "LeftORight")
+}
+
+func TestScreenDrawRegionWideCharacters(t *testing.T) {
+	src := NewScreenSimulation(10, 1)
+	style := lipgloss.NewStyle()
+	src.DrawString(0, 0, "你好", style)
+
+	dst := NewScreenSimulation(10, 2)
+	dst.DrawRegion(0, 0, src.Screen, 0, 0, 4, 1)
+	AssertCellRune(t, dst, 0, 0, '你')
+	AssertCellRune(t, dst, 2, 0, '好')
+
+	// Starting inside a wide character blanks the cut half
+	dst.DrawRegion(0, 1, src.Screen, 1, 0, 3, 1)
+	AssertCellRune(t, dst, 0, 1, ' ')
+	AssertCellRune(t, dst, 1, 1, '好')
+}
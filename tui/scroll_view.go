@@ -0,0 +1,502 @@
+package tui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ScrollView displays a child component on a virtual canvas that can be
+// taller or wider than the visible area. The child is drawn offscreen and
+// the visible portion is copied to the screen.
+type ScrollView struct {
+	content        Component
+	contentWidth   int // Virtual canvas width (0 = viewport width)
+	contentHeight  int // Virtual canvas height (0 = viewport height)
+	offsetX        int
+	offsetY        int
+	width          int // Last viewport width
+	height         int // Last viewport height
+	focused        bool
+	showScrollbars bool
+	canvas         *Screen
+	lastFocusRect  Rectangle
+	keymap         *Keymap
+}
+
+// NewScrollView creates a new scroll view
+func NewScrollView() *ScrollView {
+	return &ScrollView{
+		width:          40,
+		height:         10,
+		focused:        false,
+		showScrollbars: true,
+		keymap:         DefaultScrollViewKeymap(),
+	}
+}
+
+// DefaultScrollViewKeymap returns the default key bindings of a ScrollView
+func DefaultScrollViewKeymap() *Keymap {
+	return NewKeymap("scrollview",
+		KeyBinding{Action: "up", Keys: []string{"up", "k"}, Help: "scroll up"},
+		KeyBinding{Action: "down", Keys: []string{"down", "j"}, Help: "scroll down"},
+		KeyBinding{Action: "left", Keys: []string{"left", "h"}, Help: "scroll left"},
+		KeyBinding{Action: "right", Keys: []string{"right", "l"}, Help: "scroll right"},
+		KeyBinding{Action: "page-up", Keys: []string{"pgup"}, Help: "page up"},
+		KeyBinding{Action: "page-down", Keys: []string{"pgdown"}, Help: "page down"},
+		KeyBinding{Action: "page-left", Keys: []string{"shift+left"}, Help: "page left"},
+		KeyBinding{Action: "page-right", Keys: []string{"shift+right"}, Help: "page right"},
+		KeyBinding{Action: "top", Keys: []string{"home", "ctrl+home"}, Help: "top"},
+		KeyBinding{Action: "bottom", Keys: []string{"end", "ctrl+end"}, Help: "bottom"},
+	)
+}
+
+// Keymap returns the scroll view's key bindings
+func (s *ScrollView) Keymap() *Keymap {
+	return s.keymap
+}
+
+// SetKeymap sets the scroll view's key bindings
+func (s *ScrollView) SetKeymap(keymap *Keymap) {
+	s.keymap = keymap
+}
+
+// SetContent sets the component drawn on the virtual canvas
+func (s *ScrollView) SetContent(component Component) {
+	s.content = component
+	s.offsetX = 0
+	s.offsetY = 0
+}
+
+// GetContent returns the component drawn on the virtual canvas
+func (s *ScrollView) GetContent() Component {
+	return s.content
+}
+
+// Children returns the content, so focus can move to fields inside it
+func (s *ScrollView) Children() []Component {
+	if s.content == nil {
+		return nil
+	}
+	return []Component{s.content}
+}
+
+// SetContentSize sets the size of the virtual canvas
+// A value of 0 uses the viewport size for that dimension
+func (s *ScrollView) SetContentSize(width, height int) {
+	s.contentWidth = width
+	s.contentHeight = height
+	s.clampOffset()
+}
+
+// GetContentSize returns the size of the virtual canvas
+func (s *ScrollView) GetContentSize() (width, height int) {
+	return s.canvasSize()
+}
+
+// SetShowScrollbars sets whether scrollbars are drawn when content overflows
+func (s *ScrollView) SetShowScrollbars(show bool) {
+	s.showScrollbars = show
+}
+
+// ScrollTo scrolls so the given canvas position is at the top-left corner
+func (s *ScrollView) ScrollTo(x, y int) {
+	s.offsetX = x
+	s.offsetY = y
+	s.clampOffset()
+}
+
+// ScrollBy scrolls by the given number of cells
+func (s *ScrollView) ScrollBy(dx, dy int) {
+	s.ScrollTo(s.offsetX+dx, s.offsetY+dy)
+}
+
+// GetScrollOffset returns the canvas position shown at the top-left corner
+func (s *ScrollView) GetScrollOffset() (x, y int) {
+	return s.offsetX, s.offsetY
+}
+
+// ScrollIntoView scrolls the minimum amount needed to make the given
+// canvas rectangle visible
+func (s *ScrollView) ScrollIntoView(rect Rectangle) {
+	viewWidth, viewHeight := s.viewportSize()
+
+	if rect.X+rect.Width > s.offsetX+viewWidth {
+		s.offsetX = rect.X + rect.Width - viewWidth
+	}
+	if rect.X < s.offsetX {
+		s.offsetX = rect.X
+	}
+	if rect.Y+rect.Height > s.offsetY+viewHeight {
+		s.offsetY = rect.Y + rect.Height - viewHeight
+	}
+	if rect.Y < s.offsetY {
+		s.offsetY = rect.Y
+	}
+	s.clampOffset()
+}
+
+// Focus gives keyboard focus to this component
+func (s *ScrollView) Focus() {
+	s.focused = true
+}
+
+// Blur removes keyboard focus from this component
+func (s *ScrollView) Blur() {
+	s.focused = false
+}
+
+// IsFocused returns whether this component currently has focus
+func (s *ScrollView) IsFocused() bool {
+	return s.focused
+}
+
+// HandleInput processes keyboard and mouse wheel input
+// Wheel events use the strings produced by tea.MouseMsg ("wheel up", ...).
+// Scroll keys are passed to the content while one of its descendants has focus,
+// and ignored while nothing in the view has focus.
+func (s *ScrollView) HandleInput(key string) {
+	if s.handleWheel(key) {
+		return
+	}
+	if s.contentFocused() {
+		s.content.HandleInput(key)
+		return
+	}
+	if !s.focused {
+		return
+	}
+	s.handleScrollKey(key)
+}
+
+// HandleKey processes keyboard input when focused
+func (s *ScrollView) HandleKey(key string) bool {
+	if s.handleWheel(key) {
+		return true
+	}
+	if s.contentFocused() {
+		if handler, ok := s.content.(interface{ HandleKey(string) bool }); ok {
+			return handler.HandleKey(key)
+		}
+		s.content.HandleInput(key)
+		return true
+	}
+	if !s.focused {
+		return false
+	}
+	return s.handleScrollKey(key)
+}
+
+// handleWheel scrolls in response to mouse wheel events
+func (s *ScrollView) handleWheel(key string) bool {
+	switch key {
+	case "wheel up":
+		s.ScrollBy(0, -3)
+	case "wheel down":
+		s.ScrollBy(0, 3)
+	case "wheel left":
+		s.ScrollBy(-3, 0)
+	case "wheel right":
+		s.ScrollBy(3, 0)
+	default:
+		return false
+	}
+	return true
+}
+
+// handleScrollKey scrolls in response to navigation keys
+func (s *ScrollView) handleScrollKey(key string) bool {
+	viewWidth, viewHeight := s.viewportSize()
+
+	switch s.keymap.Action(key) {
+	case "up":
+		s.ScrollBy(0, -1)
+	case "down":
+		s.ScrollBy(0, 1)
+	case "left":
+		s.ScrollBy(-1, 0)
+	case "right":
+		s.ScrollBy(1, 0)
+	case "page-up":
+		s.ScrollBy(0, -(viewHeight - 1))
+	case "page-down":
+		s.ScrollBy(0, viewHeight-1)
+	case "page-left":
+		s.ScrollBy(-(viewWidth - 1), 0)
+	case "page-right":
+		s.ScrollBy(viewWidth-1, 0)
+	case "top":
+		s.ScrollTo(0, 0)
+	case "bottom":
+		_, canvasHeight := s.canvasSize()
+		s.ScrollTo(s.offsetX, canvasHeight)
+	default:
+		return false
+	}
+	return true
+}
+
+// contentFocused returns whether a descendant of the scroll view has focus
+func (s *ScrollView) contentFocused() bool {
+	if s.content == nil {
+		return false
+	}
+	focusable, ok := s.content.(Focusable)
+	return ok && focusable.IsFocused()
+}
+
+// scrollbars determines which scrollbars are needed for the current viewport
+func (s *ScrollView) scrollbars() (vertical, horizontal bool) {
+	if !s.showScrollbars {
+		return false, false
+	}
+	contentWidth, contentHeight := s.contentWidth, s.contentHeight
+
+	// Adding one scrollbar can shrink the viewport enough to need the other
+	vertical = contentHeight > s.height
+	horizontal = contentWidth > s.width
+	if vertical && !horizontal {
+		horizontal = contentWidth > s.width-1
+	}
+	if horizontal && !vertical {
+		vertical = contentHeight > s.height-1
+	}
+	return vertical, horizontal
+}
+
+// viewportSize returns the visible canvas area, excluding scrollbars
+func (s *ScrollView) viewportSize() (width, height int) {
+	width, height = s.width, s.height
+	vertical, horizontal := s.scrollbars()
+	if vertical {
+		width--
+	}
+	if horizontal {
+		height--
+	}
+	if width < 0 {
+		width = 0
+	}
+	if height < 0 {
+		height = 0
+	}
+	return width, height
+}
+
+// canvasSize returns the canvas size for the current viewport
+func (s *ScrollView) canvasSize() (width, height int) {
+	viewWidth, viewHeight := s.viewportSize()
+	width, height = s.contentWidth, s.contentHeight
+	if width <= 0 {
+		width = viewWidth
+	}
+	if height <= 0 {
+		height = viewHeight
+	}
+	return width, height
+}
+
+// clampOffset keeps the scroll offset within the canvas
+func (s *ScrollView) clampOffset() {
+	viewWidth, viewHeight := s.viewportSize()
+	canvasWidth, canvasHeight := s.canvasSize()
+
+	maxX := canvasWidth - viewWidth
+	if maxX < 0 {
+		maxX = 0
+	}
+	maxY := canvasHeight - viewHeight
+	if maxY < 0 {
+		maxY = 0
+	}
+
+	if s.offsetX > maxX {
+		s.offsetX = maxX
+	}
+	if s.offsetX < 0 {
+		s.offsetX = 0
+	}
+	if s.offsetY > maxY {
+		s.offsetY = maxY
+	}
+	if s.offsetY < 0 {
+		s.offsetY = 0
+	}
+}
+
+// focusedRect returns the canvas rectangle of the innermost focused
+// component drawn during the last frame
+func (s *ScrollView) focusedRect() (Rectangle, bool) {
+	var rect Rectangle
+	found := false
+	// Children are recorded after their parents, so the last match is innermost
+	for _, drawn := range s.canvas.DrawnRects() {
+		if focusable, ok := drawn.Component.(Focusable); ok && focusable.IsFocused() {
+			rect = drawn.Rect
+			found = true
+		}
+	}
+	return rect, found
+}
+
+// Draw renders the scroll view to the screen
+func (s *ScrollView) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	// ScrollView decides to use all available space for its viewport
+	s.width = availableWidth
+	s.height = availableHeight
+
+	ClearComponentArea(screen, x, y, s.width, s.height, theme)
+
+	if s.content == nil || s.width <= 0 || s.height <= 0 {
+		return
+	}
+
+	viewWidth, viewHeight := s.viewportSize()
+	canvasWidth, canvasHeight := s.canvasSize()
+
+	// Draw the content onto the offscreen canvas
+	if s.canvas == nil || s.canvas.Width() != canvasWidth || s.canvas.Height() != canvasHeight ||
+		s.canvas.Theme().Name != theme.Name {
+		s.canvas = NewScreen(canvasWidth, canvasHeight, *theme)
+		s.canvas.SetRectTracking(true)
+	} else {
+		s.canvas.Clear()
+	}
+	queue := screen.overlayQueue()
+	queued := len(queue.overlays)
+	s.drawContent(screen, x, y, theme)
+
+	// Follow focus when the focused descendant moves or changes
+	offsetX, offsetY := s.offsetX, s.offsetY
+	if rect, ok := s.focusedRect(); ok && rect != s.lastFocusRect {
+		s.lastFocusRect = rect
+		s.ScrollIntoView(rect)
+	}
+	s.clampOffset()
+	if s.offsetX != offsetX || s.offsetY != offsetY {
+		// Popups in the content were placed for the old offset
+		queue.overlays = queue.overlays[:queued]
+		s.canvas.Clear()
+		s.drawContent(screen, x, y, theme)
+	}
+
+	// Copy the visible portion to the screen
+	screen.DrawRegion(x, y, s.canvas, s.offsetX, s.offsetY, viewWidth, viewHeight)
+
+	s.drawScrollbars(screen, x, y, viewWidth, viewHeight, canvasWidth, canvasHeight, theme)
+}
+
+// drawContent draws the content onto the offscreen canvas
+// Popups the content opens go to the real screen, so they can extend past
+// the viewport.
+func (s *ScrollView) drawContent(screen *Screen, x, y int, theme *Theme) {
+	s.canvas.setOverlayTarget(screen, x-s.offsetX, y-s.offsetY)
+	s.canvas.DrawComponent(s.content, 0, 0, s.canvas.Width(), s.canvas.Height(), theme)
+}
+
+// drawScrollbars draws the vertical and horizontal scrollbars
+func (s *ScrollView) drawScrollbars(screen *Screen, x, y, viewWidth, viewHeight, canvasWidth, canvasHeight int, theme *Theme) {
+	vertical, horizontal := s.scrollbars()
+
+	trackStyle := lipgloss.NewStyle().
+		Foreground(theme.Palette.TextMuted).
+		Background(theme.Palette.Background)
+
+	thumbColor := theme.Palette.TextMuted
+	if s.focused {
+		thumbColor = theme.Palette.Primary
+	}
+	thumbStyle := lipgloss.NewStyle().
+		Foreground(thumbColor).
+		Background(theme.Palette.Background)
+
+	if vertical && viewHeight > 0 {
+		barX := x + viewWidth
+		for i := 0; i < viewHeight; i++ {
+			screen.DrawRune(barX, y+i, '│', trackStyle)
+		}
+		thumbPos, thumbSize := scrollThumb(viewHeight, canvasHeight, s.offsetY)
+		for i := 0; i < thumbSize; i++ {
+			screen.DrawRune(barX, y+thumbPos+i, '█', thumbStyle)
+		}
+	}
+
+	if horizontal && viewWidth > 0 {
+		barY := y + viewHeight
+		for i := 0; i < viewWidth; i++ {
+			screen.DrawRune(x+i, barY, '─', trackStyle)
+		}
+		thumbPos, thumbSize := scrollThumb(viewWidth, canvasWidth, s.offsetX)
+		for i := 0; i < thumbSize; i++ {
+			screen.DrawRune(x+thumbPos+i, barY, '█', thumbStyle)
+		}
+	}
+}
+
+// scrollThumb calculates the position and size of a scrollbar thumb
+func scrollThumb(viewSize, contentSize, offset int) (pos, size int) {
+	if contentSize <= 0 {
+		return 0, viewSize
+	}
+
+	size = int(float64(viewSize) * float64(viewSize) / float64(contentSize))
+	if size < 1 {
+		size = 1
+	}
+	if size > viewSize {
+		size = viewSize
+	}
+
+	maxScroll := contentSize - viewSize
+	if maxScroll > 0 {
+		scrollRatio := float64(offset) / float64(maxScroll)
+		pos = int(scrollRatio * float64(viewSize-size))
+	}
+	return pos, size
+}
+
+// drawVerticalScrollbar draws a one column scrollbar for lists of rows
+func drawVerticalScrollbar(screen *Screen, x, y, height, contentSize, offset int, focused bool, theme *Theme) {
+	trackStyle := lipgloss.NewStyle().
+		Foreground(theme.Palette.TextMuted).
+		Background(theme.Components.Interactive.Normal.Background)
+	thumbColor := theme.Palette.TextMuted
+	if focused {
+		thumbColor = theme.Palette.Primary
+	}
+	thumbStyle := trackStyle.Foreground(thumbColor)
+
+	for i := 0; i < height; i++ {
+		screen.DrawRune(x, y+i, '│', trackStyle)
+	}
+	thumbPos, thumbSize := scrollThumb(height, contentSize, offset)
+	for i := 0; i < thumbSize; i++ {
+		screen.DrawRune(x, y+thumbPos+i, '█', thumbStyle)
+	}
+}
+
+// Measure returns the virtual canvas size, or the content's preferred size
+// when no canvas size is set
+func (s *ScrollView) Measure(maxWidth, maxHeight int) (width, height int) {
+	width, height = s.contentWidth, s.contentHeight
+	if s.content != nil && (width <= 0 || height <= 0) {
+		measuredWidth, measuredHeight := MeasureComponent(s.content, maxWidth, maxHeight)
+		if width <= 0 {
+			width = measuredWidth
+		}
+		if height <= 0 {
+			height = measuredHeight
+		}
+	}
+	return width, height
+}
+
+// SetSize sets the width and height of the viewport
+func (s *ScrollView) SetSize(width, height int) {
+	s.width = width
+	s.height = height
+	s.clampOffset()
+}
+
+// GetSize returns the current width and height
+func (s *ScrollView) GetSize() (width, height int) {
+	return s.width, s.height
+}
@@ -0,0 +1,192 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// numberedLines builds multi-line content for scrolling tests
+func numberedLines(count int) string {
+	lines := make([]string, count)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("Line %02d", i)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestScrollViewDrawsVisiblePortion(t *testing.T) {
+	screen := NewScreenSimulation(20, 5)
+	theme := NewTestTheme()
+
+	sv := NewScrollView()
+	sv.SetContent(NewTestComponent(numberedLines(20), 10, 20))
+	sv.SetContentSize(0, 20)
+	sv.Draw(screen.Screen, 0, 0, 20, 5, theme)
+
+	AssertTextExists(t, screen, "Line 00")
+	AssertTextExists(t, screen, "Line 04")
+	AssertTextNotExists(t, screen, "Line 05")
+
+	sv.ScrollTo(0, 10)
+	screen.Clear()
+	sv.Draw(screen.Screen, 0, 0, 20, 5, theme)
+
+	AssertTextExists(t, screen, "Line 10")
+	AssertTextNotExists(t, screen, "Line 00")
+}
+
+func TestScrollViewClampsOffset(t *testing.T) {
+	screen := NewScreenSimulation(20, 5)
+	theme := NewTestTheme()
+
+	sv := NewScrollView()
+	sv.SetContent(NewTestComponent(numberedLines(20), 10, 20))
+	sv.SetContentSize(0, 20)
+	sv.Draw(screen.Screen, 0, 0, 20, 5, theme)
+
+	sv.ScrollTo(0, 100)
+	if _, y := sv.GetScrollOffset(); y != 15 {
+		t.Errorf("Expected offset clamped to 15, got %d", y)
+	}
+
+	sv.ScrollBy(0, -100)
+	if _, y := sv.GetScrollOffset(); y != 0 {
+		t.Errorf("Expected offset clamped to 0, got %d", y)
+	}
+}
+
+func TestScrollViewKeyboardAndWheel(t *testing.T) {
+	screen := NewScreenSimulation(20, 5)
+	theme := NewTestTheme()
+
+	sv := NewScrollView()
+	sv.SetContent(NewTestComponent(numberedLines(20), 10, 20))
+	sv.SetContentSize(0, 20)
+	sv.Focus()
+	sv.Draw(screen.Screen, 0, 0, 20, 5, theme)
+
+	sv.HandleInput("down")
+	if _, y := sv.GetScrollOffset(); y != 1 {
+		t.Errorf("Expected offset 1 after down, got %d", y)
+	}
+
+	sv.HandleInput("wheel down")
+	if _, y := sv.GetScrollOffset(); y != 4 {
+		t.Errorf("Expected offset 4 after wheel down, got %d", y)
+	}
+
+	sv.HandleInput("end")
+	if _, y := sv.GetScrollOffset(); y != 15 {
+		t.Errorf("Expected offset 15 after end, got %d", y)
+	}
+
+	sv.HandleInput("home")
+	if _, y := sv.GetScrollOffset(); y != 0 {
+		t.Errorf("Expected offset 0 after home, got %d", y)
+	}
+
+	// Keys only scroll while the view has focus
+	sv.Blur()
+	sv.HandleInput("down")
+	if _, y := sv.GetScrollOffset(); y != 0 {
+		t.Errorf("Expected an unfocused view to ignore keys, got offset %d", y)
+	}
+}
+
+func TestScrollViewScrollbars(t *testing.T) {
+	screen := NewScreenSimulation(20, 5)
+	theme := NewTestTheme()
+
+	sv := NewScrollView()
+	sv.SetContent(NewTestComponent(numberedLines(20), 10, 20))
+	sv.SetContentSize(40, 20)
+	sv.Draw(screen.Screen, 0, 0, 20, 5, theme)
+
+	// Vertical scrollbar in the last column, horizontal in the last row
+	AssertCellRune(t, screen, 19, 0, '█')
+	AssertCellRune(t, screen, 0, 4, '█')
+
+	sv.SetShowScrollbars(false)
+	screen.Clear()
+	sv.Draw(screen.Screen, 0, 0, 20, 5, theme)
+	if screen.CountOccurrences('█') != 0 {
+		t.Error("Expected no scrollbars when disabled")
+	}
+}
+
+func TestScrollViewFollowsFocus(t *testing.T) {
+	screen := NewScreenSimulation(20, 5)
+	theme := NewTestTheme()
+
+	first := NewTestComponent("First", 10, 1)
+	last := NewTestComponent("Last", 10, 1)
+
+	layout := VBox()
+	layout.AddFixed(first, 1)
+	layout.AddFixed(NewTestComponent("", 10, 18), 18)
+	layout.AddFixed(last, 1)
+
+	sv := NewScrollView()
+	sv.SetContent(layout)
+	sv.SetContentSize(0, 20)
+	sv.Draw(screen.Screen, 0, 0, 20, 5, theme)
+
+	AssertTextExists(t, screen, "First")
+
+	first.Blur()
+	last.Focus()
+	screen.Clear()
+	sv.Draw(screen.Screen, 0, 0, 20, 5, theme)
+
+	AssertTextExists(t, screen, "Last")
+	AssertTextNotExists(t, screen, "First")
+}
+
+func TestScrollViewShowsPopupsOutsideTheViewport(t *testing.T) {
+	sim := NewScreenSimulation(30, 10)
+	theme := NewTestTheme()
+
+	sel := NewSelect("alpha", "bravo", "charlie")
+	sel.Focus()
+	sel.Open()
+
+	layout := VBox()
+	layout.AddFixed(NewTestComponent(numberedLines(5), 10, 5), 5)
+	layout.AddFixed(sel, 1)
+
+	sv := NewScrollView()
+	sv.SetContent(layout)
+	sv.SetContentSize(0, 6)
+	sv.Draw(sim.Screen.Sub(2, 1, 20, 3), 0, 0, 20, 3, theme)
+	sim.Screen.Render()
+
+	// Following focus scrolls the select to the viewport's last row, and
+	// its popup opens below that, past the viewport's bottom edge
+	AssertCellRune(t, sim, 2, 4, '┌')
+	AssertTextExists(t, sim, "bravo")
+	AssertTextExists(t, sim, "charlie")
+
+	// Scrolled far down near the bottom of the screen, the popup has no
+	// room below and is cut short above to stay on screen, drawn once at
+	// the final offset
+	long := NewSelect("alpha", "bravo", "charlie", "delta")
+	long.Focus()
+	long.Open()
+	layout = VBox()
+	layout.AddFixed(NewTestComponent(numberedLines(20), 10, 20), 20)
+	layout.AddFixed(long, 1)
+	sv = NewScrollView()
+	sv.SetContent(layout)
+	sv.SetContentSize(0, 21)
+
+	sim = NewScreenSimulation(30, 6)
+	sv.Draw(sim.Screen.Sub(2, 3, 20, 3), 0, 0, 20, 3, theme)
+	sim.Screen.Render()
+
+	AssertCellRune(t, sim, 2, 0, '┌')
+	AssertCellRune(t, sim, 2, 4, '└')
+	if count := sim.CountOccurrences('┌'); count != 1 {
+		t.Errorf("Expected the popup drawn once, got %d", count)
+	}
+}
@@ -0,0 +1,464 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Select is a dropdown for picking one of several options
+//
+// Closed, it shows the chosen option on one row. Opened, it draws a list of
+// options in a popup above the rest of the UI, placed below or above the
+// field so it stays on screen. Typing while open filters the options with
+// fuzzy matching, which also makes it usable as a combo box over long
+// lists. The popup closes when an option is picked, on esc, or when the
+// select loses focus.
+type Select struct {
+	options     []string
+	selected    int // Index into options, or -1 when nothing is chosen
+	placeholder string
+	open        bool
+	filter      string
+	results     []FuzzyResult
+	cursor      int // Index into results
+	offset      int // First result shown in the popup
+	maxVisible  int
+	focused     bool
+	disabled    bool
+	onChange    func(index int, option string)
+	keymap      *Keymap
+	popupKeymap *Keymap
+}
+
+// NewSelect creates a closed select with nothing chosen
+func NewSelect(options ...string) *Select {
+	return &Select{
+		options:     options,
+		selected:    -1,
+		placeholder: "Select…",
+		maxVisible:  8,
+		keymap:      DefaultSelectKeymap(),
+		popupKeymap: DefaultSelectPopupKeymap(),
+	}
+}
+
+// DefaultSelectKeymap returns the default key bindings of a closed Select
+func DefaultSelectKeymap() *Keymap {
+	return NewKeymap("select",
+		KeyBinding{Action: "open", Keys: []string{"enter", " ", "alt+down"}, Help: "open"},
+	)
+}
+
+// DefaultSelectPopupKeymap returns the key bindings used while a Select's
+// popup is open
+// Other printable keys are added to the filter.
+func DefaultSelectPopupKeymap() *Keymap {
+	return NewKeymap("select-popup",
+		KeyBinding{Action: "up", Keys: []string{"up", "ctrl+p"}, Help: "previous option"},
+		KeyBinding{Action: "down", Keys: []string{"down", "ctrl+n"}, Help: "next option"},
+		KeyBinding{Action: "page-up", Keys: []string{"pgup"}, Help: "page up"},
+		KeyBinding{Action: "page-down", Keys: []string{"pgdown"}, Help: "page down"},
+		KeyBinding{Action: "accept", Keys: []string{"enter"}, Help: "choose"},
+		KeyBinding{Action: "close", Keys: []string{"esc"}, Help: "close"},
+		KeyBinding{Action: "delete-back", Keys: []string{"backspace", "ctrl+h"}, Help: "delete filter character"},
+	)
+}
+
+// Keymap returns the select's key bindings
+func (s *Select) Keymap() *Keymap {
+	return s.keymap
+}
+
+// SetKeymap sets the select's key bindings
+func (s *Select) SetKeymap(keymap *Keymap) {
+	s.keymap = keymap
+}
+
+// PopupKeymap returns the key bindings used while the popup is open
+func (s *Select) PopupKeymap() *Keymap {
+	return s.popupKeymap
+}
+
+// SetPopupKeymap sets the key bindings used while the popup is open
+func (s *Select) SetPopupKeymap(keymap *Keymap) {
+	s.popupKeymap = keymap
+}
+
+// Options returns the options
+func (s *Select) Options() []string {
+	return s.options
+}
+
+// SetOptions replaces the options, keeping the choice if it's still there
+func (s *Select) SetOptions(options ...string) {
+	value := s.Value()
+	s.options = options
+	s.selected = -1
+	s.SetValue(value)
+	if s.open {
+		s.refilter()
+	}
+}
+
+// SetPlaceholder sets the text shown while nothing is chosen
+func (s *Select) SetPlaceholder(placeholder string) {
+	s.placeholder = placeholder
+}
+
+// SetMaxVisible sets how many options the popup shows before scrolling
+func (s *Select) SetMaxVisible(rows int) {
+	s.maxVisible = max(1, rows)
+}
+
+// Selected returns the index of the chosen option, or -1
+func (s *Select) Selected() int {
+	return s.selected
+}
+
+// Value returns the chosen option, or "" when nothing is chosen
+func (s *Select) Value() string {
+	if s.selected >= 0 && s.selected < len(s.options) {
+		return s.options[s.selected]
+	}
+	return ""
+}
+
+// SetSelected chooses an option by index without calling OnChange
+// An out of range index clears the choice.
+func (s *Select) SetSelected(index int) {
+	if index < 0 || index >= len(s.options) {
+		index = -1
+	}
+	s.selected = index
+}
+
+// SetValue chooses an option by text without calling OnChange
+// It returns false when no option matches.
+func (s *Select) SetValue(option string) bool {
+	for i, o := range s.options {
+		if o == option {
+			s.selected = i
+			return true
+		}
+	}
+	return false
+}
+
+// SetDisabled sets whether the select ignores input
+func (s *Select) SetDisabled(disabled bool) {
+	s.disabled = disabled
+	if disabled {
+		s.Close()
+	}
+}
+
+// IsDisabled returns whether the select is disabled
+func (s *Select) IsDisabled() bool {
+	return s.disabled
+}
+
+// OnChange sets the function called when the user chooses an option
+func (s *Select) OnChange(handler func(index int, option string)) {
+	s.onChange = handler
+}
+
+// Open shows the popup with the cursor on the chosen option
+func (s *Select) Open() {
+	if s.disabled || s.open {
+		return
+	}
+	s.open = true
+	s.filter = ""
+	s.refilter()
+	for i, result := range s.results {
+		if result.Index == s.selected {
+			s.cursor = i
+		}
+	}
+}
+
+// Close hides the popup without changing the choice
+func (s *Select) Close() {
+	s.open = false
+	s.filter = ""
+	s.results = nil
+}
+
+// IsOpen returns whether the popup is showing
+func (s *Select) IsOpen() bool {
+	return s.open
+}
+
+// Filter returns the text typed to filter the options
+func (s *Select) Filter() string {
+	return s.filter
+}
+
+// SetFilter filters the options, opening the popup if needed
+func (s *Select) SetFilter(filter string) {
+	s.Open()
+	if !s.open {
+		return
+	}
+	s.filter = filter
+	s.refilter()
+}
+
+// refilter matches the filter against the options and resets the cursor
+func (s *Select) refilter() {
+	s.results = FuzzyFilter(s.filter, s.options)
+	s.cursor = 0
+	s.offset = 0
+}
+
+// Choose picks an option by index, closing the popup and calling OnChange
+// if the choice changed
+func (s *Select) Choose(index int) bool {
+	if s.disabled || index < 0 || index >= len(s.options) {
+		return false
+	}
+	s.Close()
+	if index != s.selected {
+		s.selected = index
+		if s.onChange != nil {
+			s.onChange(index, s.options[index])
+		}
+	}
+	return true
+}
+
+// move moves the popup's cursor, stopping at the ends
+func (s *Select) move(delta int) {
+	if len(s.results) == 0 {
+		return
+	}
+	s.cursor = max(0, min(s.cursor+delta, len(s.results)-1))
+}
+
+// FormValue returns the chosen option
+func (s *Select) FormValue() any {
+	return s.Value()
+}
+
+// SetFormValue chooses an option from its text or index
+func (s *Select) SetFormValue(value any) error {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			s.selected = -1
+		} else if !s.SetValue(v) {
+			return fmt.Errorf("tui: %q is not an option", v)
+		}
+	case int:
+		if v < -1 || v >= len(s.options) {
+			return fmt.Errorf("tui: option %d out of range", v)
+		}
+		s.selected = v
+	default:
+		return fmt.Errorf("tui: select value must be a string or int, got %T", value)
+	}
+	return nil
+}
+
+// HandleKey opens the popup, or moves through and filters the options
+// while it's open, returning whether the key was used
+// Typing while closed opens the popup with the typed text as the filter.
+func (s *Select) HandleKey(key string) bool {
+	if s.disabled {
+		return false
+	}
+	if !s.open {
+		switch {
+		case s.keymap.Matches(key, "open"):
+			s.Open()
+		case isPrintable(key):
+			s.SetFilter(key)
+		default:
+			return false
+		}
+		return true
+	}
+
+	switch s.popupKeymap.Action(key) {
+	case "accept":
+		if s.cursor < len(s.results) {
+			s.Choose(s.results[s.cursor].Index)
+		}
+	case "close":
+		s.Close()
+	case "up":
+		s.move(-1)
+	case "down":
+		s.move(1)
+	case "page-up":
+		s.move(-s.maxVisible)
+	case "page-down":
+		s.move(s.maxVisible)
+	case "delete-back":
+		if s.filter != "" {
+			runes := []rune(s.filter)
+			s.SetFilter(string(runes[:len(runes)-1]))
+		}
+	default:
+		if !isPrintable(key) {
+			return false
+		}
+		s.SetFilter(s.filter + key)
+	}
+	return true
+}
+
+// isPrintable returns whether a key is a single printable character
+func isPrintable(key string) bool {
+	runes := []rune(key)
+	return len(runes) == 1 && runes[0] >= 32 && runes[0] != 127
+}
+
+// HandleInput processes keyboard input
+func (s *Select) HandleInput(key string) {
+	s.HandleKey(key)
+}
+
+// Focus gives keyboard focus to the select
+func (s *Select) Focus() {
+	s.focused = true
+}
+
+// Blur removes keyboard focus from the select and closes the popup
+func (s *Select) Blur() {
+	s.focused = false
+	s.Close()
+}
+
+// IsFocused returns whether the select has focus
+func (s *Select) IsFocused() bool {
+	return s.focused
+}
+
+// Measure returns the width of the longest option and the arrow
+func (s *Select) Measure(maxWidth, maxHeight int) (width, height int) {
+	width = StringWidth(s.placeholder)
+	for _, option := range s.options {
+		width = max(width, StringWidth(option))
+	}
+	return min(width+3, maxWidth), min(1, maxHeight)
+}
+
+// Draw renders the chosen option with an arrow, and queues the popup with
+// DeferOverlay while open
+func (s *Select) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	if availableWidth <= 0 || availableHeight <= 0 {
+		return
+	}
+	style := controlStyle(theme, s.disabled, s.focused)
+	ClearArea(screen, x, y, availableWidth, 1, style)
+
+	text, textStyle := s.Value(), style
+	switch {
+	case s.open && s.filter != "":
+		text, textStyle = s.filter, style.Foreground(theme.Palette.Primary)
+	case s.selected < 0:
+		text, textStyle = s.placeholder, style.Foreground(theme.Palette.TextMuted)
+	}
+	arrow := "▾"
+	if s.open {
+		arrow = "▴"
+	}
+	screen.DrawString(x, y, Truncate(text, max(0, availableWidth-2)), textStyle)
+	screen.DrawString(x+availableWidth-1, y, arrow, style)
+
+	if s.open {
+		originX, originY := screen.OverlayOrigin()
+		anchor := Rectangle{X: originX + x, Y: originY + y, Width: availableWidth, Height: 1}
+		screen.DeferOverlay(func(root *Screen) {
+			s.drawPopup(root, anchor, theme)
+		})
+	}
+}
+
+// drawPopup draws the bordered option list next to the field
+func (s *Select) drawPopup(screen *Screen, anchor Rectangle, theme *Theme) {
+	rows := max(1, min(len(s.results), s.maxVisible))
+	width := anchor.Width
+	for _, result := range s.results {
+		width = max(width, StringWidth(s.options[result.Index])+4)
+	}
+	bounds := Rectangle{Width: screen.Width(), Height: screen.Height()}
+	rect := PlacePopup(anchor, width, rows+2, bounds)
+	if rect.Width < 3 || rect.Height < 3 {
+		return
+	}
+	rows = rect.Height - 2
+
+	background := lipgloss.NewStyle().Background(theme.Palette.Overlay)
+	ClearArea(screen, rect.X, rect.Y, rect.Width, rect.Height, background)
+	screen.DrawBox(rect.X, rect.Y, rect.Width, rect.Height, background.Foreground(theme.Palette.Border))
+
+	innerX, innerWidth := rect.X+1, rect.Width-2
+	if len(s.results) == 0 {
+		emptyStyle := background.Foreground(theme.Palette.TextMuted).Italic(true)
+		screen.DrawString(innerX+1, rect.Y+1, Truncate("No matches", innerWidth-1), emptyStyle)
+		return
+	}
+
+	if s.cursor < s.offset {
+		s.offset = s.cursor
+	}
+	if s.cursor >= s.offset+rows {
+		s.offset = s.cursor - rows + 1
+	}
+	scrollbar := len(s.results) > rows
+	if scrollbar {
+		innerWidth--
+	}
+
+	textStyle := background.Foreground(theme.Palette.Text)
+	hoverColors := theme.Components.Interactive.Hover
+	for i := 0; i < rows && s.offset+i < len(s.results); i++ {
+		result := s.results[s.offset+i]
+		row := rect.Y + 1 + i
+		style := textStyle
+		marker := "  "
+		if s.offset+i == s.cursor {
+			style = style.Foreground(hoverColors.Text).Bold(true)
+			marker = "▶ "
+		}
+		if result.Index == s.selected {
+			style = style.Foreground(theme.Components.Interactive.Selected.Text)
+			if s.offset+i == s.cursor {
+				style = style.Foreground(hoverColors.Text)
+			}
+		}
+		screen.DrawString(innerX, row, strings.Repeat(" ", innerWidth), style)
+		screen.DrawString(innerX, row, marker, style)
+		drawHighlighted(screen, innerX+2, row, innerWidth-2, s.options[result.Index], result.Positions, style, style.Foreground(theme.Palette.Gold))
+	}
+
+	if scrollbar {
+		drawVerticalScrollbar(screen, innerX+innerWidth, rect.Y+1, rows, len(s.results), s.offset, true, theme)
+	}
+}
+
+// drawHighlighted draws text with the runes at the given positions in a
+// highlight style, cut to a width
+func drawHighlighted(screen *Screen, x, y, width int, text string, positions []int, style, highlight lipgloss.Style) {
+	matched := map[int]bool{}
+	for _, position := range positions {
+		matched[position] = true
+	}
+	col := x
+	for i, r := range []rune(text) {
+		w := RuneWidth(r)
+		if col+w > x+width {
+			break
+		}
+		if matched[i] {
+			screen.DrawRune(col, y, r, highlight)
+		} else {
+			screen.DrawRune(col, y, r, style)
+		}
+		col += w
+	}
+}
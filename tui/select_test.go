@@ -0,0 +1,140 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestSelectChooseWithKeys(t *testing.T) {
+	var changes []string
+	sel := NewSelect("Tokyo Night", "Catppuccin", "Rose Pine")
+	sel.OnChange(func(index int, option string) { changes = append(changes, option) })
+
+	if sel.Value() != "" || sel.Selected() != -1 {
+		t.Fatal("Expected nothing chosen")
+	}
+	sel.HandleKey("enter")
+	if !sel.IsOpen() {
+		t.Fatal("Expected enter to open the popup")
+	}
+	sel.HandleKey("down")
+	sel.HandleKey("down")
+	sel.HandleKey("down") // Stops at the last option
+	sel.HandleKey("enter")
+	if sel.IsOpen() || sel.Value() != "Rose Pine" {
+		t.Errorf("Expected Rose Pine chosen and closed, got %q (open %v)", sel.Value(), sel.IsOpen())
+	}
+
+	// Reopening starts on the chosen option; esc keeps the choice
+	sel.HandleKey(" ")
+	sel.HandleKey("up")
+	sel.HandleKey("esc")
+	if sel.Value() != "Rose Pine" {
+		t.Errorf("Expected esc to keep the choice, got %q", sel.Value())
+	}
+	if len(changes) != 1 {
+		t.Errorf("Expected one change, got %v", changes)
+	}
+}
+
+func TestSelectFiltering(t *testing.T) {
+	sel := NewSelect("Tokyo Night", "Catppuccin", "Rose Pine", "Monochrome")
+
+	sel.HandleKey("r") // Typing opens the popup
+	sel.HandleKey("p")
+	if !sel.IsOpen() || sel.Filter() != "rp" {
+		t.Fatalf("Expected an open popup filtering by rp, got %q", sel.Filter())
+	}
+	sel.HandleKey("enter")
+	if sel.Value() != "Rose Pine" {
+		t.Errorf("Expected the best match chosen, got %q", sel.Value())
+	}
+
+	sel.SetFilter("zzz")
+	sel.HandleKey("enter") // No matches, nothing to choose
+	if !sel.IsOpen() || sel.Value() != "Rose Pine" {
+		t.Error("Expected enter without matches to do nothing")
+	}
+	sel.HandleKey("backspace")
+	if sel.Filter() != "zz" {
+		t.Errorf("Expected backspace to edit the filter, got %q", sel.Filter())
+	}
+}
+
+func TestSelectClosesOnBlur(t *testing.T) {
+	sel := NewSelect("a", "b")
+	sel.Focus()
+	sel.Open()
+	sel.Blur()
+	if sel.IsOpen() {
+		t.Error("Expected blur to close the popup")
+	}
+
+	sel.SetDisabled(true)
+	if sel.HandleKey("enter") || sel.IsOpen() {
+		t.Error("Expected a disabled select to stay closed")
+	}
+}
+
+func TestSelectFormValue(t *testing.T) {
+	sel := NewSelect("small", "large")
+	if err := sel.SetFormValue("large"); err != nil || sel.FormValue() != "large" {
+		t.Errorf("Expected large, got %v (%v)", sel.FormValue(), err)
+	}
+	if err := sel.SetFormValue("huge"); err == nil {
+		t.Error("Expected an error for an unknown option")
+	}
+
+	sel.SetOptions("tiny", "large")
+	if sel.Value() != "large" || sel.Selected() != 1 {
+		t.Errorf("Expected the choice kept across new options, got %q", sel.Value())
+	}
+}
+
+func TestSelectDrawsPopupAboveTheUI(t *testing.T) {
+	sim := NewScreenSimulation(30, 8)
+	theme := NewTestTheme()
+	sel := NewSelect("Alpha", "Beta", "Gamma")
+	sel.SetValue("Beta")
+	sel.Focus()
+
+	sel.Draw(sim.Screen.Sub(2, 1, 12, 1), 0, 0, 12, 1, theme)
+	sim.Screen.Render()
+	AssertTextExists(t, sim, "Beta")
+	AssertCellRune(t, sim, 13, 1, '▾')
+	AssertTextNotExists(t, sim, "Alpha")
+
+	sel.Open()
+	sim.Screen.Clear()
+	sel.Draw(sim.Screen.Sub(2, 1, 12, 1), 0, 0, 12, 1, theme)
+	sim.Screen.DrawString(0, 3, "content drawn later", lipgloss.NewStyle())
+	sim.Screen.Render()
+
+	// A bordered popup right under the field, on top of the later content
+	AssertCellRune(t, sim, 2, 2, '┌')
+	AssertTextExists(t, sim, "  Alpha")
+	AssertTextExists(t, sim, "▶ Beta")
+	AssertTextNotExists(t, sim, "content drawn later")
+}
+
+func TestFormGivesOpenSelectItsKeys(t *testing.T) {
+	form := NewForm()
+	form.Keymap().SetKeys("next", "tab", "down")
+	form.AddField("size", "Size", NewSelect("small", "large"))
+	form.AddField("name", "Name", NewInput())
+	form.Focus()
+
+	form.HandleKey("enter") // Opens the select
+	form.HandleKey("down")  // Moves in the popup instead of to the next field
+	form.HandleKey("esc")   // Closes the popup instead of cancelling
+	if form.Focused() != form.Field("size") {
+		t.Fatal("Expected the select to keep focus while open")
+	}
+	form.HandleKey("enter")
+	form.HandleKey("down")
+	form.HandleKey("enter")
+	if form.Value("size") != "large" {
+		t.Errorf("Expected large chosen, got %v", form.Value("size"))
+	}
+}
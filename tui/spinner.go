@@ -0,0 +1,165 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SpinnerFrames is a set of frames a Spinner cycles through, and how long
+// each one shows
+type SpinnerFrames struct {
+	Frames   []string
+	Interval time.Duration
+}
+
+// Built-in spinner frame sets
+var (
+	SpinnerDots   = SpinnerFrames{Frames: []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}, Interval: 80 * time.Millisecond}
+	SpinnerLine   = SpinnerFrames{Frames: []string{"-", "\\", "|", "/"}, Interval: 130 * time.Millisecond}
+	SpinnerCircle = SpinnerFrames{Frames: []string{"◐", "◓", "◑", "◒"}, Interval: 120 * time.Millisecond}
+	SpinnerArc    = SpinnerFrames{Frames: []string{"◜", "◠", "◝", "◞", "◡", "◟"}, Interval: 100 * time.Millisecond}
+	SpinnerPulse  = SpinnerFrames{Frames: []string{"∙", "●", "⬤", "●"}, Interval: 150 * time.Millisecond}
+)
+
+// Spinner is an animated activity indicator with an optional label
+//
+// The frame is worked out from the time since Start, so the animation keeps
+// pace however often the screen is drawn. Frames advance through the Timed
+// interface: pass the command from Start or TimerCmd to bubbletea and
+// TimerMsgs to HandleTimer. A stopped spinner shows only its label.
+type Spinner struct {
+	frames  SpinnerFrames
+	label   string
+	role    ColorRole
+	running bool
+	start   time.Time
+	timer   *Timer
+}
+
+// NewSpinner creates a stopped spinner using the dots frames
+func NewSpinner() *Spinner {
+	return &Spinner{
+		frames: SpinnerDots,
+		role:   RolePrimary,
+		timer:  NewTimer(nil),
+	}
+}
+
+// SetFrames sets the frames the spinner cycles through
+func (s *Spinner) SetFrames(frames SpinnerFrames) {
+	s.frames = frames
+}
+
+// SetLabel sets the text shown after the frame
+func (s *Spinner) SetLabel(label string) {
+	s.label = label
+}
+
+// Label returns the text shown after the frame
+func (s *Spinner) Label() string {
+	return s.label
+}
+
+// SetColor sets the palette role the frame is drawn in
+func (s *Spinner) SetColor(role ColorRole) {
+	s.role = role
+}
+
+// SetClock sets the clock used for the animation
+func (s *Spinner) SetClock(clock Clock) {
+	s.timer.SetClock(clock)
+}
+
+// Start starts the animation from the first frame and returns the command
+// for the next frame
+func (s *Spinner) Start() tea.Cmd {
+	s.running = true
+	s.start = s.timer.Clock().Now()
+	return s.TimerCmd()
+}
+
+// Stop stops the animation
+func (s *Spinner) Stop() {
+	s.running = false
+	s.timer.Stop()
+}
+
+// IsRunning returns whether the spinner is animating
+func (s *Spinner) IsRunning() bool {
+	return s.running
+}
+
+// step returns how many intervals have passed since Start
+func (s *Spinner) step() time.Duration {
+	if s.frames.Interval <= 0 {
+		return 0
+	}
+	return s.timer.Clock().Now().Sub(s.start) / s.frames.Interval
+}
+
+// Frame returns the current frame, or "" while stopped
+func (s *Spinner) Frame() string {
+	if !s.running || len(s.frames.Frames) == 0 {
+		return ""
+	}
+	return s.frames.Frames[int(s.step())%len(s.frames.Frames)]
+}
+
+// TimerCmd returns a command that fires when the next frame is due
+func (s *Spinner) TimerCmd() tea.Cmd {
+	if !s.running || s.frames.Interval <= 0 || len(s.frames.Frames) < 2 {
+		s.timer.Stop()
+		return nil
+	}
+	return s.timer.Schedule(s.start.Add((s.step() + 1) * s.frames.Interval))
+}
+
+// HandleTimer schedules the next frame when the frame timer fires
+// Returns nil if msg isn't for this spinner.
+func (s *Spinner) HandleTimer(msg TimerMsg) tea.Cmd {
+	if !s.timer.Fired(msg) {
+		return nil
+	}
+	return s.TimerCmd()
+}
+
+// text returns the frame and label as drawn
+func (s *Spinner) text() (frame, label string) {
+	frame = s.Frame()
+	label = s.label
+	if frame != "" && label != "" {
+		label = " " + label
+	}
+	return frame, label
+}
+
+// Measure returns the width of the widest frame and the label
+func (s *Spinner) Measure(maxWidth, maxHeight int) (width, height int) {
+	for _, frame := range s.frames.Frames {
+		width = max(width, StringWidth(frame))
+	}
+	if s.label != "" {
+		width += 1 + StringWidth(s.label)
+	}
+	return min(width, maxWidth), min(1, maxHeight)
+}
+
+// Draw renders the current frame followed by the label
+func (s *Spinner) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	if availableWidth <= 0 || availableHeight <= 0 {
+		return
+	}
+	frame, label := s.text()
+	frameStyle := lipgloss.NewStyle().Foreground(s.role.Color(theme.Palette))
+	screen.DrawString(x, y, Truncate(frame, availableWidth), frameStyle)
+
+	labelX := x + StringWidth(frame)
+	if width := x + availableWidth - labelX; width > 0 {
+		screen.DrawString(labelX, y, Truncate(label, width), lipgloss.NewStyle().Foreground(theme.Palette.Text))
+	}
+}
+
+// HandleInput does nothing; spinners don't take input
+func (s *Spinner) HandleInput(key string) {}
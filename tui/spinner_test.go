@@ -0,0 +1,56 @@
+package tui
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSpinnerFramesFollowTheClock(t *testing.T) {
+	clock := NewFakeClock(testEpoch)
+	spinner := NewSpinner()
+	spinner.SetClock(clock)
+	spinner.SetFrames(SpinnerLine)
+
+	if spinner.Frame() != "" || spinner.TimerCmd() != nil {
+		t.Fatal("Expected a stopped spinner to show no frame and schedule nothing")
+	}
+
+	cmd := spinner.Start()
+	if spinner.Frame() != "-" {
+		t.Errorf("Expected the first frame, got %q", spinner.Frame())
+	}
+	msg := runTimerCmd(t, clock, cmd, SpinnerLine.Interval)
+	if spinner.HandleTimer(msg) == nil {
+		t.Error("Expected the next frame to be scheduled")
+	}
+	if spinner.Frame() != "\\" {
+		t.Errorf("Expected the second frame, got %q", spinner.Frame())
+	}
+
+	// Frames wrap around, however late the draw is
+	clock.Advance(3*SpinnerLine.Interval + time.Millisecond)
+	if spinner.Frame() != "-" {
+		t.Errorf("Expected the frames to wrap, got %q", spinner.Frame())
+	}
+
+	spinner.Stop()
+	if spinner.HandleTimer(msg) != nil || spinner.IsRunning() {
+		t.Error("Expected a stopped spinner to ignore its timer")
+	}
+}
+
+func TestSpinnerDraw(t *testing.T) {
+	spinner := NewSpinner()
+	spinner.SetClock(NewFakeClock(testEpoch))
+	spinner.SetFrames(SpinnerCircle)
+	spinner.SetLabel("Sending request")
+	spinner.Start()
+
+	sim := NewScreenSimulation(20, 1)
+	spinner.Draw(sim.Screen, 0, 0, 20, 1, NewTestTheme())
+	AssertTextExists(t, sim, "◐ Sending request")
+
+	if w, _ := spinner.Measure(100, 1); w != 17 {
+		t.Errorf("Expected width 17, got %d", w)
+	}
+}
@@ -91,14 +91,14 @@ func (s *Split) drawWithBounds(screen *Screen, x, y, width, height int, theme *T
 
 		// Draw first pane
 		if s.first != nil && firstSize > 0 {
-			s.first.Draw(screen, x, y, firstSize, height, theme)
+			screen.DrawConstrainedComponent(s.first, s.constraint.String(), x, y, firstSize, height, theme)
 		}
 
 		// Draw second pane
 		secondX := x + firstSize
 		secondWidth := width - firstSize
 		if s.second != nil && secondWidth > 0 {
-			s.second.Draw(screen, secondX, y, secondWidth, height, theme)
+			screen.DrawConstrainedComponent(s.second, "Remaining", secondX, y, secondWidth, height, theme)
 		}
 	} else {
 		// Horizontal split - calculate height for first pane
@@ -106,18 +106,40 @@ func (s *Split) drawWithBounds(screen *Screen, x, y, width, height int, theme *T
 
 		// Draw first pane
 		if s.first != nil && firstSize > 0 {
-			s.first.Draw(screen, x, y, width, firstSize, theme)
+			screen.DrawConstrainedComponent(s.first, s.constraint.String(), x, y, width, firstSize, theme)
 		}
 
 		// Draw second pane
 		secondY := y + firstSize
 		secondHeight := height - firstSize
 		if s.second != nil && secondHeight > 0 {
-			s.second.Draw(screen, x, secondY, width, secondHeight, theme)
+			screen.DrawConstrainedComponent(s.second, "Remaining", x, secondY, width, secondHeight, theme)
 		}
 	}
 }
 
+// Measure returns the size needed to fit both panes side by side
+func (s *Split) Measure(maxWidth, maxHeight int) (width, height int) {
+	var firstWidth, firstHeight, secondWidth, secondHeight int
+	if s.first != nil {
+		firstWidth, firstHeight = MeasureComponent(s.first, maxWidth, maxHeight)
+	}
+	if s.second != nil {
+		secondWidth, secondHeight = MeasureComponent(s.second, maxWidth, maxHeight)
+	}
+
+	if s.vertical {
+		if s.constraint.Base.Type == Length {
+			firstWidth = s.constraint.Calculate(maxWidth, 1.0)
+		}
+		return firstWidth + secondWidth, max(firstHeight, secondHeight)
+	}
+	if s.constraint.Base.Type == Length {
+		firstHeight = s.constraint.Calculate(maxHeight, 1.0)
+	}
+	return max(firstWidth, secondWidth), firstHeight + secondHeight
+}
+
 // GetFirst returns the first pane component
 func (s *Split) GetFirst() Component {
 	return s.first
@@ -143,60 +165,41 @@ func (s *Split) IsVertical() bool {
 // Focus gives keyboard focus to this component
 func (s *Split) Focus() {
 	// Focus first pane
-	if s.first != nil {
-		if focusable, ok := s.first.(Focusable); ok {
-			focusable.Focus()
-		}
-	}
+	focusFirst(s.Children())
 }
 
 // Blur removes keyboard focus from this component
 func (s *Split) Blur() {
 	// Blur both panes
-	if s.first != nil {
-		if focusable, ok := s.first.(Focusable); ok {
-			focusable.Blur()
-		}
-	}
-	if s.second != nil {
-		if focusable, ok := s.second.(Focusable); ok {
-			focusable.Blur()
-		}
-	}
+	blurAll(s.Children())
 }
 
 // IsFocused returns whether this component currently has focus
 func (s *Split) IsFocused() bool {
 	// Check if either pane is focused
-	if s.first != nil {
-		if focusable, ok := s.first.(Focusable); ok && focusable.IsFocused() {
-			return true
-		}
-	}
-	if s.second != nil {
-		if focusable, ok := s.second.(Focusable); ok && focusable.IsFocused() {
-			return true
-		}
-	}
-	return false
+	return anyFocused(s.Children())
 }
 
 // HandleKey processes keyboard input when focused
 func (s *Split) HandleKey(key string) bool {
 	// Pass to focused pane
+	return handleKeyFocused(s.Children(), key)
+}
+
+// ForwardsFocus reports that the split only passes focus on to its
+// children
+func (s *Split) ForwardsFocus() bool {
+	return true
+}
+
+// Children returns the panes that are set
+func (s *Split) Children() []Component {
+	var children []Component
 	if s.first != nil {
-		if handler, ok := s.first.(interface{ HandleKey(string) bool }); ok {
-			if focusable, ok := s.first.(Focusable); ok && focusable.IsFocused() {
-				return handler.HandleKey(key)
-			}
-		}
+		children = append(children, s.first)
 	}
 	if s.second != nil {
-		if handler, ok := s.second.(interface{ HandleKey(string) bool }); ok {
-			if focusable, ok := s.second.(Focusable); ok && focusable.IsFocused() {
-				return handler.HandleKey(key)
-			}
-		}
+		children = append(children, s.second)
 	}
-	return false
+	return children
 }
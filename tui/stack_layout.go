@@ -140,6 +140,17 @@ func (s *Stack) drawWithBounds(screen *Screen, x, y, width, height int, theme *T
 		itemWidth := item.Width.Calculate(width, 1.0)
 		itemHeight := item.Height.Calculate(height, 1.0)
 
+		// Resolve fit-content sizes from the component's measurement
+		if item.Width.Base.Type == Auto || item.Height.Base.Type == Auto {
+			measuredWidth, measuredHeight := MeasureComponent(item.Component, width, height)
+			if item.Width.Base.Type == Auto {
+				itemWidth = item.Width.resolveAuto(measuredWidth).Calculate(width, 1.0)
+			}
+			if item.Height.Base.Type == Auto {
+				itemHeight = item.Height.resolveAuto(measuredHeight).Calculate(height, 1.0)
+			}
+		}
+
 		// Apply alignment adjustments
 		switch item.Alignment.Horizontal {
 		case AlignCenter:
@@ -175,8 +186,35 @@ func (s *Stack) drawWithBounds(screen *Screen, x, y, width, height int, theme *T
 		}
 
 		// Draw the component
-		item.Component.Draw(screen, itemX, itemY, itemWidth, itemHeight, theme)
+		constraint := "w=" + item.Width.String() + " h=" + item.Height.String()
+		screen.DrawConstrainedComponent(item.Component, constraint, itemX, itemY, itemWidth, itemHeight, theme)
+	}
+}
+
+// AddAuto adds a component at the top-left corner, sized to its measured size
+func (s *Stack) AddAuto(component Component) {
+	s.Add(
+		component,
+		NewConstraintSet(NewLength(0)),
+		NewConstraintSet(NewLength(0)),
+		NewConstraintSet(NewAuto()),
+		NewConstraintSet(NewAuto()),
+	)
+}
+
+// AddAutoCentered adds a component centered in the stack, sized to its measured size
+func (s *Stack) AddAutoCentered(component Component) {
+	s.AddCentered(component, NewConstraintSet(NewAuto()), NewConstraintSet(NewAuto()))
+}
+
+// Measure returns the size needed to fit the largest layer
+func (s *Stack) Measure(maxWidth, maxHeight int) (width, height int) {
+	for _, item := range s.items {
+		itemWidth, itemHeight := MeasureComponent(item.Component, maxWidth, maxHeight)
+		width = max(width, itemWidth)
+		height = max(height, itemHeight)
 	}
+	return width, height
 }
 
 // Clear removes all items from the stack
@@ -225,46 +263,38 @@ func (s *Stack) SendToBack(index int) {
 // Focus gives keyboard focus to this component
 func (s *Stack) Focus() {
 	// Focus the top-most focusable item
-	for i := len(s.items) - 1; i >= 0; i-- {
-		if focusable, ok := s.items[i].Component.(Focusable); ok {
-			focusable.Focus()
-			break
-		}
-	}
+	focusFirst(reversed(s.Children()))
 }
 
 // Blur removes keyboard focus from this component
 func (s *Stack) Blur() {
 	// Blur all items
-	for _, item := range s.items {
-		if focusable, ok := item.Component.(Focusable); ok {
-			focusable.Blur()
-		}
-	}
+	blurAll(s.Children())
 }
 
 // IsFocused returns whether this component currently has focus
 func (s *Stack) IsFocused() bool {
 	// Check if any item is focused
-	for _, item := range s.items {
-		if focusable, ok := item.Component.(Focusable); ok {
-			if focusable.IsFocused() {
-				return true
-			}
-		}
-	}
-	return false
+	return anyFocused(s.Children())
 }
 
 // HandleKey processes keyboard input when focused
 func (s *Stack) HandleKey(key string) bool {
 	// Pass to focused item (top-most first)
-	for i := len(s.items) - 1; i >= 0; i-- {
-		if handler, ok := s.items[i].Component.(interface{ HandleKey(string) bool }); ok {
-			if focusable, ok := s.items[i].Component.(Focusable); ok && focusable.IsFocused() {
-				return handler.HandleKey(key)
-			}
-		}
+	return handleKeyFocused(reversed(s.Children()), key)
+}
+
+// ForwardsFocus reports that the stack only passes focus on to its
+// children
+func (s *Stack) ForwardsFocus() bool {
+	return true
+}
+
+// Children returns the stacked components, bottom first
+func (s *Stack) Children() []Component {
+	children := make([]Component, len(s.items))
+	for i, item := range s.items {
+		children[i] = item.Component
 	}
-	return false
+	return children
 }
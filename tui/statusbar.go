@@ -2,6 +2,7 @@ package tui
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -10,10 +11,20 @@ import (
 // StatusBarSegment represents a single segment in the status bar
 type StatusBarSegment struct {
 	Text      string
-	Alignment string // "left", "center", "right"
+	TextFunc  func() string // When set, called on every draw instead of using Text
+	Element   InlineElement // When set, drawn instead of any text
+	Alignment string        // "left", "center", "right"
 	Style     lipgloss.Style
 }
 
+// text returns the segment's current text
+func (s StatusBarSegment) text() string {
+	if s.TextFunc != nil {
+		return s.TextFunc()
+	}
+	return s.Text
+}
+
 // StatusBar represents a status bar component typically shown at the bottom
 type StatusBar struct {
 	segments []StatusBarSegment
@@ -36,6 +47,25 @@ func (s *StatusBar) AddSegment(text, alignment string) {
 	})
 }
 
+// AddDynamicSegment adds a segment whose text is computed on every draw,
+// such as KeySequencer.PendingText
+// The segment is left out while its text is empty.
+func (s *StatusBar) AddDynamicSegment(text func() string, alignment string) {
+	s.segments = append(s.segments, StatusBarSegment{
+		TextFunc:  text,
+		Alignment: alignment,
+	})
+}
+
+// AddElement adds a segment drawn by an inline element, such as a
+// ComponentElement wrapping a Spinner
+func (s *StatusBar) AddElement(element InlineElement, alignment string) {
+	s.segments = append(s.segments, StatusBarSegment{
+		Element:   element,
+		Alignment: alignment,
+	})
+}
+
 // SetSegments replaces all segments
 func (s *StatusBar) SetSegments(segments []StatusBarSegment) {
 	s.segments = segments
@@ -71,6 +101,12 @@ func (s *StatusBar) Draw(screen *Screen, x, y, availableWidth, availableHeight i
 	// Group segments by alignment
 	var leftSegments, centerSegments, rightSegments []StatusBarSegment
 	for _, segment := range s.segments {
+		if segment.TextFunc != nil && segment.Element == nil {
+			segment.Text = segment.TextFunc()
+			if segment.Text == "" {
+				continue
+			}
+		}
 		switch segment.Alignment {
 		case "center":
 			centerSegments = append(centerSegments, segment)
@@ -88,6 +124,10 @@ func (s *StatusBar) Draw(screen *Screen, x, y, availableWidth, availableHeight i
 			screen.DrawString(currentX, y, " | ", bgStyle)
 			currentX += 3
 		}
+		if segment.Element != nil {
+			currentX += segment.Element.Draw(screen, currentX, y, theme, false)
+			continue
+		}
 		style := bgStyle
 		if segment.Style.GetForeground() != nil || segment.Style.GetBackground() != nil {
 			style = segment.Style
@@ -98,28 +138,51 @@ func (s *StatusBar) Draw(screen *Screen, x, y, availableWidth, availableHeight i
 
 	// Draw center-aligned segments
 	if len(centerSegments) > 0 {
-		centerText := ""
-		for i, segment := range centerSegments {
-			if i > 0 {
-				centerText += " | "
-			}
-			centerText += segment.Text
-		}
-		centerX := x + (barWidth-StringWidth(centerText))/2
-		screen.DrawString(centerX, y, centerText, bgStyle)
+		centerX := x + (barWidth-segmentsWidth(centerSegments))/2
+		drawPlainSegments(screen, centerX, y, centerSegments, bgStyle, theme)
 	}
 
 	// Draw right-aligned segments
 	if len(rightSegments) > 0 {
-		rightText := ""
-		for i, segment := range rightSegments {
-			if i > 0 {
-				rightText += " | "
-			}
-			rightText += segment.Text
+		rightX := x + barWidth - segmentsWidth(rightSegments) - 1
+		drawPlainSegments(screen, rightX, y, rightSegments, bgStyle, theme)
+	}
+}
+
+// width returns the segment's width on the bar
+func (s StatusBarSegment) width() int {
+	if s.Element != nil {
+		return s.Element.Width()
+	}
+	return StringWidth(s.text())
+}
+
+// segmentsWidth returns the width of segments joined by separators
+func segmentsWidth(segments []StatusBarSegment) int {
+	width := 0
+	for i, segment := range segments {
+		if i > 0 {
+			width += 3 // " | " separator
 		}
-		rightX := x + barWidth - StringWidth(rightText) - 1
-		screen.DrawString(rightX, y, rightText, bgStyle)
+		width += segment.width()
+	}
+	return width
+}
+
+// drawPlainSegments draws segments joined by separators, with their text in
+// the bar's style
+func drawPlainSegments(screen *Screen, x, y int, segments []StatusBarSegment, style lipgloss.Style, theme *Theme) {
+	for i, segment := range segments {
+		if i > 0 {
+			screen.DrawString(x, y, " | ", style)
+			x += 3
+		}
+		if segment.Element != nil {
+			x += segment.Element.Draw(screen, x, y, theme, false)
+			continue
+		}
+		screen.DrawString(x, y, segment.Text, style)
+		x += StringWidth(segment.Text)
 	}
 }
 
@@ -138,16 +201,40 @@ func (s *StatusBar) GetHeight() int {
 	return s.height
 }
 
+// Measure returns the width of all segments and the status bar height
+func (s *StatusBar) Measure(maxWidth, maxHeight int) (width, height int) {
+	count := 0
+	for _, segment := range s.segments {
+		if segment.Element == nil && segment.TextFunc != nil && segment.text() == "" {
+			continue
+		}
+		if count > 0 {
+			width += 3 // " | " separator
+		}
+		width += segment.width()
+		count++
+	}
+	return width, s.height
+}
+
 // Helper functions for common status bar patterns
 
 // NewHelpStatusBar creates a status bar with common help text
+// Shortcuts are listed in key order; use NewKeymapStatusBar to control the
+// order.
 func NewHelpStatusBar(shortcuts map[string]string) *StatusBar {
 	sb := NewStatusBar()
 
-	// Build help text from shortcuts map
+	// Build help text from shortcuts map, sorted so it doesn't reshuffle
+	keys := make([]string, 0, len(shortcuts))
+	for key := range shortcuts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
 	var parts []string
-	for key, action := range shortcuts {
-		parts = append(parts, key+":"+action)
+	for _, key := range keys {
+		parts = append(parts, key+":"+shortcuts[key])
 	}
 	helpText := strings.Join(parts, " ")
 
@@ -155,6 +242,14 @@ func NewHelpStatusBar(shortcuts map[string]string) *StatusBar {
 	return sb
 }
 
+// NewKeymapStatusBar creates a status bar with hints for the active key
+// bindings, in the order they were defined
+func NewKeymapStatusBar(keymaps ...*Keymap) *StatusBar {
+	sb := NewStatusBar()
+	sb.AddSegment(KeyHints(keymaps...), "right")
+	return sb
+}
+
 // NewFileStatusBar creates a status bar for file editing
 func NewFileStatusBar(filename, mode string, line, col int) *StatusBar {
 	sb := NewStatusBar()
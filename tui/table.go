@@ -27,6 +27,8 @@ type Table struct {
 	editCursor   int
 	height       int // Maximum visible rows
 	scrollOffset int
+	keymap       *Keymap
+	editKeymap   *Keymap
 }
 
 // NewTable creates a new table
@@ -39,9 +41,53 @@ func NewTable() *Table {
 		focused:     false,
 		editable:    true,
 		height:      5,
+		keymap:      DefaultTableKeymap(),
+		editKeymap:  DefaultTableEditKeymap(),
 	}
 }
 
+// DefaultTableKeymap returns the default key bindings for navigating a Table
+func DefaultTableKeymap() *Keymap {
+	return NewKeymap("table",
+		KeyBinding{Action: "up", Keys: []string{"up", "k"}, Help: "up"},
+		KeyBinding{Action: "down", Keys: []string{"down", "j"}, Help: "down"},
+		KeyBinding{Action: "left", Keys: []string{"left", "h"}, Help: "left"},
+		KeyBinding{Action: "right", Keys: []string{"right", "l"}, Help: "right"},
+		KeyBinding{Action: "edit", Keys: []string{"enter"}, Help: "edit cell"},
+		KeyBinding{Action: "add-row", Keys: []string{"n"}, Help: "add row"},
+		KeyBinding{Action: "delete-row", Keys: []string{"d"}, Help: "delete row"},
+	)
+}
+
+// DefaultTableEditKeymap returns the default key bindings for editing a
+// Table cell
+func DefaultTableEditKeymap() *Keymap {
+	return NewKeymap("table-edit", append([]KeyBinding{
+		{Action: "save", Keys: []string{"enter"}, Help: "save"},
+		{Action: "cancel", Keys: []string{"esc"}, Help: "cancel"},
+	}, lineEditBindings()...)...)
+}
+
+// Keymap returns the key bindings for navigating the table
+func (t *Table) Keymap() *Keymap {
+	return t.keymap
+}
+
+// SetKeymap sets the key bindings for navigating the table
+func (t *Table) SetKeymap(keymap *Keymap) {
+	t.keymap = keymap
+}
+
+// EditKeymap returns the key bindings used while editing a cell
+func (t *Table) EditKeymap() *Keymap {
+	return t.editKeymap
+}
+
+// SetEditKeymap sets the key bindings used while editing a cell
+func (t *Table) SetEditKeymap(keymap *Keymap) {
+	t.editKeymap = keymap
+}
+
 // SetColumns defines the table columns
 func (t *Table) SetColumns(columns []TableColumn) {
 	t.columns = columns
@@ -109,32 +155,32 @@ func (t *Table) HandleInput(key string) {
 		return
 	}
 
-	switch key {
-	case "up", "k":
+	switch t.keymap.Action(key) {
+	case "up":
 		if t.selectedRow > 0 {
 			t.selectedRow--
 			t.adjustScroll()
 		}
-	case "down", "j":
+	case "down":
 		if len(t.rows) > 0 && t.selectedRow < len(t.rows)-1 {
 			t.selectedRow++
 			t.adjustScroll()
 		}
-	case "left", "h":
+	case "left":
 		if len(t.columns) > 0 && t.selectedCol > 0 {
 			t.selectedCol--
 		}
-	case "right", "l":
+	case "right":
 		if len(t.columns) > 0 && t.selectedCol < len(t.columns)-1 {
 			t.selectedCol++
 		}
-	case "enter":
+	case "edit":
 		if t.editable && t.selectedRow < len(t.rows) && t.selectedCol < len(t.columns) {
 			t.editingCell = true
 			t.editValue = t.GetValue(t.selectedRow, t.selectedCol)
 			t.editCursor = len(t.editValue)
 		}
-	case "n":
+	case "add-row":
 		// Add new row
 		if t.editable {
 			newRow := make(TableRow, len(t.columns))
@@ -142,7 +188,7 @@ func (t *Table) HandleInput(key string) {
 			t.selectedRow = len(t.rows) - 1
 			t.adjustScroll()
 		}
-	case "d":
+	case "delete-row":
 		// Delete current row
 		if t.editable && len(t.rows) > 0 && t.selectedRow < len(t.rows) {
 			t.RemoveRow(t.selectedRow)
@@ -151,25 +197,25 @@ func (t *Table) HandleInput(key string) {
 }
 
 func (t *Table) handleEditKey(key string) {
-	switch key {
-	case "enter":
+	switch t.editKeymap.Action(key) {
+	case "save":
 		// Save the edit
 		t.SetValue(t.selectedRow, t.selectedCol, t.editValue)
 		t.editingCell = false
-	case "esc":
+	case "cancel":
 		// Cancel the edit
 		t.editingCell = false
-	case "left", "ctrl+b":
+	case "cursor-left":
 		t.moveCursorLeftTable()
-	case "right", "ctrl+f":
+	case "cursor-right":
 		t.moveCursorRightTable()
-	case "home", "ctrl+a":
+	case "line-start":
 		t.editCursor = 0
-	case "end", "ctrl+e":
+	case "line-end":
 		t.editCursor = StringWidth(t.editValue)
-	case "backspace", "ctrl+h":
+	case "delete-back":
 		t.deleteBeforeCursorTable()
-	case "delete", "ctrl+d":
+	case "delete-forward":
 		t.deleteAtCursorTable()
 	default:
 		// Handle regular character input
@@ -421,6 +467,15 @@ func (t *Table) GetSize() (width, height int) {
 	return totalWidth - 1, t.height
 }
 
+// Measure returns the size needed to show every column and row
+func (t *Table) Measure(maxWidth, maxHeight int) (width, height int) {
+	rows := len(t.rows)
+	if rows == 0 {
+		rows = 1 // Empty table message
+	}
+	return max(t.getTableWidth(), 0), rows + 2 // +2 for header and separator
+}
+
 // SetSize sets the width and height of the component
 func (t *Table) SetSize(width, height int) {
 	// Table uses column widths, so we just set height
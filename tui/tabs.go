@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -20,6 +21,7 @@ type TabsComponent struct {
 	height      int
 	focused     bool
 	renderStyle TabRenderStyle
+	keymap      *Keymap
 }
 
 // TabRenderStyle defines how tabs are rendered
@@ -39,9 +41,33 @@ func NewTabs() *TabsComponent {
 		height:      10,
 		focused:     false,
 		renderStyle: TabsOnTop,
+		keymap:      DefaultTabsKeymap(),
 	}
 }
 
+// DefaultTabsKeymap returns the default key bindings of a TabsComponent
+// The "tab-1" to "tab-9" actions jump straight to a tab.
+func DefaultTabsKeymap() *Keymap {
+	keymap := NewKeymap("tabs",
+		KeyBinding{Action: "previous-tab", Keys: []string{"left", "h"}, Help: "previous tab"},
+		KeyBinding{Action: "next-tab", Keys: []string{"right", "l"}, Help: "next tab"},
+	)
+	for n := 1; n <= 9; n++ {
+		keymap.Bind("tab-"+strconv.Itoa(n), "", strconv.Itoa(n))
+	}
+	return keymap
+}
+
+// Keymap returns the tabs' key bindings
+func (t *TabsComponent) Keymap() *Keymap {
+	return t.keymap
+}
+
+// SetKeymap sets the tabs' key bindings
+func (t *TabsComponent) SetKeymap(keymap *Keymap) {
+	t.keymap = keymap
+}
+
 // AddTab adds a new tab
 func (t *TabsComponent) AddTab(title string, content interface{}) {
 	t.tabs = append(t.tabs, Tab{Title: title, Content: content})
@@ -353,7 +379,7 @@ func (t *TabsComponent) drawContent(screen *Screen, x, y, width, height int, the
 		}
 	case Component:
 		// Draw component content
-		content.Draw(screen, x+1, y, width-2, height-1, theme)
+		screen.DrawComponent(content, x+1, y, width-2, height-1, theme)
 	}
 }
 
@@ -372,18 +398,30 @@ func (t *TabsComponent) IsFocused() bool {
 	return t.focused
 }
 
+// Children returns the active tab's content when it's a component, so
+// focus skips the tabs that aren't shown
+func (t *TabsComponent) Children() []Component {
+	if activeTab := t.GetActiveTab(); activeTab != nil {
+		if component, ok := activeTab.Content.(Component); ok {
+			return []Component{component}
+		}
+	}
+	return nil
+}
+
 // HandleInput processes keyboard input
 func (t *TabsComponent) HandleInput(key string) {
-	switch key {
-	case "left", "h":
+	action := t.keymap.Action(key)
+	switch {
+	case action == "previous-tab":
 		t.PrevTab()
-	case "right", "l":
+	case action == "next-tab":
 		t.NextTab()
-	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+	case strings.HasPrefix(action, "tab-"):
 		// Quick tab switching
-		index := int(key[0] - '1')
-		if index < len(t.tabs) {
-			t.SetActive(index)
+		n, err := strconv.Atoi(strings.TrimPrefix(action, "tab-"))
+		if err == nil && n >= 1 && n <= len(t.tabs) {
+			t.SetActive(n - 1)
 		}
 	default:
 		// Pass key to active tab content if it's a component
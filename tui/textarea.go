@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"fmt"
 	"github.com/charmbracelet/lipgloss"
 	"strings"
 )
@@ -16,6 +17,7 @@ type TextArea struct {
 	height      int
 	focused     bool
 	placeholder string
+	keymap      *Keymap
 }
 
 // NewTextArea creates a new text area
@@ -30,9 +32,30 @@ func NewTextArea() *TextArea {
 		height:      10,
 		focused:     false,
 		placeholder: "",
+		keymap:      DefaultTextAreaKeymap(),
 	}
 }
 
+// DefaultTextAreaKeymap returns the default key bindings of a TextArea
+func DefaultTextAreaKeymap() *Keymap {
+	return NewKeymap("textarea", append([]KeyBinding{
+		{Action: "cursor-up", Keys: []string{"up", "ctrl+p"}, Help: "move up"},
+		{Action: "cursor-down", Keys: []string{"down", "ctrl+n"}, Help: "move down"},
+	}, append(lineEditBindings(),
+		KeyBinding{Action: "newline", Keys: []string{"enter"}, Help: "new line"},
+	)...)...)
+}
+
+// Keymap returns the text area's key bindings
+func (t *TextArea) Keymap() *Keymap {
+	return t.keymap
+}
+
+// SetKeymap sets the text area's key bindings
+func (t *TextArea) SetKeymap(keymap *Keymap) {
+	t.keymap = keymap
+}
+
 // SetSize sets the display dimensions
 func (t *TextArea) SetSize(width, height int) {
 	t.width = width
@@ -64,6 +87,21 @@ func (t *TextArea) Value() string {
 	return strings.Join(t.lines, "\n")
 }
 
+// FormValue returns the content as a string for Form
+func (t *TextArea) FormValue() any {
+	return t.Value()
+}
+
+// SetFormValue sets the content from a string for Form
+func (t *TextArea) SetFormValue(value any) error {
+	s, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("tui: text area value must be a string, got %T", value)
+	}
+	t.SetValue(s)
+	return nil
+}
+
 // Focus sets the focus state
 func (t *TextArea) Focus() {
 	t.focused = true
@@ -81,8 +119,8 @@ func (t *TextArea) IsFocused() bool {
 
 // HandleInput processes keyboard input
 func (t *TextArea) HandleInput(key string) {
-	switch key {
-	case "up", "ctrl+p":
+	switch t.keymap.Action(key) {
+	case "cursor-up":
 		if t.cursorRow > 0 {
 			t.cursorRow--
 			// Adjust column if the new line is shorter
@@ -92,7 +130,7 @@ func (t *TextArea) HandleInput(key string) {
 			}
 			t.adjustOffset()
 		}
-	case "down", "ctrl+n":
+	case "cursor-down":
 		if t.cursorRow < len(t.lines)-1 {
 			t.cursorRow++
 			// Adjust column if the new line is shorter
@@ -102,25 +140,25 @@ func (t *TextArea) HandleInput(key string) {
 			}
 			t.adjustOffset()
 		}
-	case "left", "ctrl+b":
+	case "cursor-left":
 		t.moveCursorLeft()
 		t.adjustOffset()
-	case "right", "ctrl+f":
+	case "cursor-right":
 		t.moveCursorRight()
 		t.adjustOffset()
-	case "home", "ctrl+a":
+	case "line-start":
 		t.cursorCol = 0
 		t.adjustOffset()
-	case "end", "ctrl+e":
+	case "line-end":
 		t.cursorCol = StringWidth(t.lines[t.cursorRow])
 		t.adjustOffset()
-	case "enter":
+	case "newline":
 		t.splitLineAtCursor()
 		t.adjustOffset()
-	case "backspace", "ctrl+h":
+	case "delete-back":
 		t.deleteBeforeCursor()
 		t.adjustOffset()
-	case "delete", "ctrl+d":
+	case "delete-forward":
 		t.deleteAtCursor()
 	default:
 		// Handle regular character input
@@ -272,6 +310,17 @@ func (t *TextArea) DrawInBox(screen *Screen, x, y, width, height int, title stri
 }
 
 
+// Measure returns the size of the text plus room for the cursor
+func (t *TextArea) Measure(maxWidth, maxHeight int) (width, height int) {
+	for _, line := range t.lines {
+		width = max(width, StringWidth(line)+1)
+	}
+	if len(t.lines) == 1 && t.lines[0] == "" {
+		width = max(width, StringWidth(t.placeholder))
+	}
+	return width, len(t.lines)
+}
+
 // GetSize returns the current width and height
 func (t *TextArea) GetSize() (width, height int) {
 	return t.width, t.height
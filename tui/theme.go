@@ -27,6 +27,7 @@ type NotificationStyle struct {
 }
 
 // NotificationStyles provides semantic styles for different notification types
+// Its colors are fixed; use NotificationStyleFor to follow the active theme.
 var NotificationStyles = struct {
 	Success NotificationStyle
 	Warning NotificationStyle
@@ -84,3 +85,50 @@ func GetAvailableThemes() []string {
 func ThemeExists(name string) bool {
 	return themes.ThemeExists(name)
 }
+
+// ColorRole names a palette color so it can be chosen ahead of time and
+// resolved against whatever theme is active when drawing
+type ColorRole string
+
+// Palette color roles
+const (
+	RolePrimary   ColorRole = "primary"
+	RoleSecondary ColorRole = "secondary"
+	RoleText      ColorRole = "text"
+	RoleTextMuted ColorRole = "text-muted"
+	RoleLove      ColorRole = "love"
+	RoleGold      ColorRole = "gold"
+	RoleRose      ColorRole = "rose"
+	RolePine      ColorRole = "pine"
+	RoleFoam      ColorRole = "foam"
+	RoleIris      ColorRole = "iris"
+	RoleBorder    ColorRole = "border"
+)
+
+// Color returns the role's color in a palette
+// Unknown roles fall back to the primary color.
+func (r ColorRole) Color(palette Palette) lipgloss.TerminalColor {
+	switch r {
+	case RoleSecondary:
+		return palette.Secondary
+	case RoleText:
+		return palette.Text
+	case RoleTextMuted:
+		return palette.TextMuted
+	case RoleLove:
+		return palette.Love
+	case RoleGold:
+		return palette.Gold
+	case RoleRose:
+		return palette.Rose
+	case RolePine:
+		return palette.Pine
+	case RoleFoam:
+		return palette.Foam
+	case RoleIris:
+		return palette.Iris
+	case RoleBorder:
+		return palette.Border
+	}
+	return palette.Primary
+}
@@ -0,0 +1,225 @@
+package tui
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Clock is the source of time for time-based components
+// Inject a FakeClock to test them deterministically.
+type Clock interface {
+	Now() time.Time
+	// After returns a channel that receives the time once d has passed
+	After(d time.Duration) <-chan time.Time
+}
+
+// SystemClock is the real wall clock
+type SystemClock struct{}
+
+// Now returns the current time
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// After waits for d to pass
+func (SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// clockOrDefault returns the clock, or the system clock if it's nil
+func clockOrDefault(clock Clock) Clock {
+	if clock == nil {
+		return SystemClock{}
+	}
+	return clock
+}
+
+// FakeClock is a clock that only moves when told to
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []fakeWaiter
+}
+
+// fakeWaiter is a pending After call on a FakeClock
+type fakeWaiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+// NewFakeClock creates a fake clock set to a time
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the fake current time
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// After returns a channel that receives the time once the clock has been
+// advanced by d
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+	c.waiters = append(c.waiters, fakeWaiter{at: c.now.Add(d), ch: ch})
+	return ch
+}
+
+// Advance moves the clock forward, firing any After calls that come due
+func (c *FakeClock) Advance(d time.Duration) {
+	c.Set(c.Now().Add(d))
+}
+
+// Set moves the clock to a time, firing any After calls that come due
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = now
+
+	sort.SliceStable(c.waiters, func(a, b int) bool {
+		return c.waiters[a].at.Before(c.waiters[b].at)
+	})
+	pending := c.waiters[:0]
+	for _, waiter := range c.waiters {
+		if waiter.at.After(now) {
+			pending = append(pending, waiter)
+		} else {
+			waiter.ch <- now
+		}
+	}
+	c.waiters = pending
+}
+
+// Pending returns the number of After calls still waiting
+func (c *FakeClock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
+
+// TimerMsg is the bubbletea message sent when a Timer's deadline passes
+// Pass it to the components with HandleTimers.
+type TimerMsg struct {
+	ID   int64
+	Tag  int
+	Time time.Time
+}
+
+// Timed is implemented by components that change on their own over time
+//
+// Instead of relying on periodic ticks, they schedule a timer for exactly
+// their next deadline: TimerCmd arms it, and HandleTimer reacts to it firing
+// and arms the next one.
+type Timed interface {
+	// TimerCmd returns a command that fires at the next deadline, or nil if
+	// there is none
+	TimerCmd() tea.Cmd
+	// HandleTimer updates the component if msg is one of its timers and
+	// returns the command for the next deadline
+	HandleTimer(msg TimerMsg) tea.Cmd
+}
+
+// HandleTimers passes a message to every component if it's a TimerMsg
+// Returns the batched commands for their next deadlines, or nil.
+func HandleTimers(msg tea.Msg, components ...Timed) tea.Cmd {
+	timerMsg, ok := msg.(TimerMsg)
+	if !ok {
+		return nil
+	}
+	var cmds []tea.Cmd
+	for _, component := range components {
+		if cmd := component.HandleTimer(timerMsg); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// timerIDs hands out unique timer IDs
+var timerIDs atomic.Int64
+
+// Timer schedules a single deadline as a bubbletea command
+//
+// Rescheduling or stopping it makes the messages of earlier commands stale,
+// so only the latest deadline is ever reported by Fired.
+type Timer struct {
+	id       int64
+	tag      int
+	clock    Clock
+	deadline time.Time
+	armed    bool
+}
+
+// NewTimer creates a timer using a clock, or the system clock if it's nil
+func NewTimer(clock Clock) *Timer {
+	return &Timer{
+		id:    timerIDs.Add(1),
+		clock: clockOrDefault(clock),
+	}
+}
+
+// SetClock sets the clock used to wait for deadlines
+func (t *Timer) SetClock(clock Clock) {
+	t.clock = clockOrDefault(clock)
+}
+
+// Clock returns the timer's clock
+func (t *Timer) Clock() Clock {
+	return t.clock
+}
+
+// Schedule arms the timer for a deadline and returns the command that waits
+// for it
+// Returns nil if the timer is already armed for that deadline.
+func (t *Timer) Schedule(deadline time.Time) tea.Cmd {
+	if t.armed && t.deadline.Equal(deadline) {
+		return nil
+	}
+	t.tag++
+	t.deadline = deadline
+	t.armed = true
+
+	id, tag, clock := t.id, t.tag, t.clock
+	return func() tea.Msg {
+		at := clock.Now()
+		if wait := deadline.Sub(at); wait > 0 {
+			at = <-clock.After(wait)
+		}
+		return TimerMsg{ID: id, Tag: tag, Time: at}
+	}
+}
+
+// Stop disarms the timer
+func (t *Timer) Stop() {
+	if t.armed {
+		t.tag++
+		t.armed = false
+	}
+}
+
+// Deadline returns the deadline the timer is armed for, if any
+func (t *Timer) Deadline() (time.Time, bool) {
+	return t.deadline, t.armed
+}
+
+// Fired returns whether msg reports this timer's current deadline, and
+// disarms the timer if so
+func (t *Timer) Fired(msg TimerMsg) bool {
+	if !t.armed || msg.ID != t.id || msg.Tag != t.tag {
+		return false
+	}
+	t.armed = false
+	return true
+}
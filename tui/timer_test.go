@@ -0,0 +1,166 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var testEpoch = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// runTimerCmd runs a timer command in the background, advances the clock and
+// returns the message it produced
+func runTimerCmd(t *testing.T, clock *FakeClock, cmd tea.Cmd, advance time.Duration) TimerMsg {
+	t.Helper()
+	if cmd == nil {
+		t.Fatal("Expected a timer command")
+	}
+	done := make(chan tea.Msg, 1)
+	go func() { done <- cmd() }()
+
+	// Wait for the command to start waiting on the clock
+	deadline := time.Now().Add(time.Second)
+	for clock.Pending() == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	clock.Advance(advance)
+
+	select {
+	case msg := <-done:
+		return msg.(TimerMsg)
+	case <-time.After(time.Second):
+		t.Fatal("Timer command never fired")
+	}
+	return TimerMsg{}
+}
+
+func TestFakeClockAfter(t *testing.T) {
+	clock := NewFakeClock(testEpoch)
+	early := clock.After(time.Second)
+	late := clock.After(3 * time.Second)
+
+	clock.Advance(2 * time.Second)
+	select {
+	case at := <-early:
+		if !at.Equal(testEpoch.Add(2 * time.Second)) {
+			t.Errorf("Expected the time the clock moved to, got %v", at)
+		}
+	default:
+		t.Error("Expected the 1s wait to fire")
+	}
+	select {
+	case <-late:
+		t.Error("Expected the 3s wait to still be pending")
+	default:
+	}
+	if clock.Pending() != 1 {
+		t.Errorf("Expected one pending wait, got %d", clock.Pending())
+	}
+}
+
+func TestTimerIgnoresStaleMessages(t *testing.T) {
+	clock := NewFakeClock(testEpoch)
+	timer := NewTimer(clock)
+
+	first := timer.Schedule(testEpoch.Add(time.Second))
+	if timer.Schedule(testEpoch.Add(time.Second)) != nil {
+		t.Error("Expected no new command for the same deadline")
+	}
+	second := timer.Schedule(testEpoch.Add(2 * time.Second))
+
+	stale := runTimerCmd(t, clock, first, time.Second)
+	if timer.Fired(stale) {
+		t.Error("Expected a rescheduled timer to ignore its old command")
+	}
+	msg := runTimerCmd(t, clock, second, time.Second)
+	if !msg.Time.Equal(testEpoch.Add(2*time.Second)) || !timer.Fired(msg) {
+		t.Fatalf("Expected the current deadline to fire, got %+v", msg)
+	}
+	if timer.Fired(msg) {
+		t.Error("Expected a deadline to fire only once")
+	}
+
+	timer.Schedule(testEpoch.Add(3 * time.Second))
+	timer.Stop()
+	if _, armed := timer.Deadline(); armed {
+		t.Error("Expected Stop to disarm the timer")
+	}
+}
+
+func TestNotificationHidesAtDeadline(t *testing.T) {
+	clock := NewFakeClock(testEpoch)
+	n := NewNotification()
+	n.SetClock(clock)
+	n.SetDuration(2 * time.Second)
+	n.ShowInfo("Saved")
+
+	cmd := n.TimerCmd()
+	msg := runTimerCmd(t, clock, cmd, 2*time.Second)
+	if next := n.HandleTimer(msg); next != nil {
+		t.Error("Expected no further timer once hidden")
+	}
+	if n.IsVisible() {
+		t.Error("Expected the notification to hide exactly at its deadline")
+	}
+
+	// Other components' timers are ignored
+	n.ShowInfo("Again")
+	n.TimerCmd()
+	if n.HandleTimer(TimerMsg{ID: -1}) != nil || !n.IsVisible() {
+		t.Error("Expected foreign timer messages to be ignored")
+	}
+}
+
+func TestNotificationCenterTimerFollowsCountdown(t *testing.T) {
+	clock := NewFakeClock(testEpoch)
+	nc := NewNotificationCenter()
+	nc.SetClock(clock)
+	nc.SetWidth(12) // A 10-cell countdown bar
+	nc.Push(Toast{Message: "Saved", Duration: time.Second})
+
+	nc.TimerCmd()
+	deadline, armed := nc.timer.Deadline()
+	if !armed || !deadline.Equal(testEpoch.Add(100*time.Millisecond)) {
+		t.Fatalf("Expected the first countdown step at 100ms, got %v", deadline.Sub(testEpoch))
+	}
+
+	clock.Advance(time.Second)
+	cmd := HandleTimers(TimerMsg{ID: nc.timer.id, Tag: nc.timer.tag, Time: clock.Now()}, nc)
+	if cmd != nil || nc.IsVisible() {
+		t.Error("Expected the toast to expire with no timer left")
+	}
+	if HandleTimers(tea.KeyMsg{}, nc) != nil {
+		t.Error("Expected other messages to be ignored")
+	}
+}
+
+func TestInputCursorBlinks(t *testing.T) {
+	clock := NewFakeClock(testEpoch)
+	input := NewInput()
+	input.SetClock(clock)
+	input.SetCursorBlink(true)
+	input.Focus()
+
+	if !input.cursorVisible() {
+		t.Error("Expected the cursor to start solid")
+	}
+	input.TimerCmd()
+	if deadline, _ := input.blinkTimer.Deadline(); !deadline.Equal(testEpoch.Add(CursorBlinkInterval)) {
+		t.Errorf("Expected a toggle after one interval, got %v", deadline.Sub(testEpoch))
+	}
+
+	clock.Advance(CursorBlinkInterval)
+	if input.cursorVisible() {
+		t.Error("Expected the cursor to blink off")
+	}
+	input.HandleInput("a")
+	if !input.cursorVisible() {
+		t.Error("Expected typing to show the cursor again")
+	}
+
+	input.Blur()
+	if input.TimerCmd() != nil {
+		t.Error("Expected no blinking while blurred")
+	}
+}
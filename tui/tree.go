@@ -0,0 +1,690 @@
+package tui
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TreeNode is a node of a Tree
+type TreeNode struct {
+	ID    string // Unique within the tree, such as a file path
+	Title string
+	Icon  string // Drawn before the title, such as "📁"
+	Leaf  bool   // Can't have children, so can't be expanded
+	Value any    // Data for the caller; not used by the tree
+}
+
+// TreeDataSource provides the nodes of a Tree
+// Children are only requested when a node is first expanded, so large or
+// slow hierarchies load lazily.
+type TreeDataSource interface {
+	Roots() ([]TreeNode, error)
+	Children(node TreeNode) ([]TreeNode, error)
+}
+
+// StaticTreeSource is a TreeDataSource holding its nodes in memory
+type StaticTreeSource struct {
+	children map[string][]TreeNode
+}
+
+// NewStaticTreeSource creates an empty static data source
+func NewStaticTreeSource() *StaticTreeSource {
+	return &StaticTreeSource{children: map[string][]TreeNode{}}
+}
+
+// Add adds nodes under the node with the given ID, or as roots when the
+// parent ID is ""
+func (s *StaticTreeSource) Add(parentID string, nodes ...TreeNode) {
+	s.children[parentID] = append(s.children[parentID], nodes...)
+}
+
+// Roots returns the root nodes
+func (s *StaticTreeSource) Roots() ([]TreeNode, error) {
+	return s.children[""], nil
+}
+
+// Children returns the nodes added under a node
+func (s *StaticTreeSource) Children(node TreeNode) ([]TreeNode, error) {
+	return s.children[node.ID], nil
+}
+
+// TreeIcons are the markers drawn in front of nodes
+type TreeIcons struct {
+	Expanded  string
+	Collapsed string
+	Leaf      string
+}
+
+// DefaultTreeIcons returns triangles for expandable nodes and blank space
+// for leaves
+func DefaultTreeIcons() TreeIcons {
+	return TreeIcons{Expanded: "▾ ", Collapsed: "▸ ", Leaf: "  "}
+}
+
+// treeEntry is a loaded node and its expansion state
+type treeEntry struct {
+	node     TreeNode
+	parent   *treeEntry
+	children []*treeEntry
+	loaded   bool
+	expanded bool
+	last     bool // Last of its siblings
+}
+
+// Tree shows hierarchical data with expandable and collapsible nodes
+//
+// Nodes come from a TreeDataSource and are connected by guide lines. Left
+// collapses a node or moves to its parent, right expands it or moves to its
+// first child. Pressing "/" starts an incremental search over the visible
+// nodes; "n" and "N" repeat it.
+type Tree struct {
+	source       TreeDataSource
+	roots        []*treeEntry
+	rows         []*treeEntry // Visible entries in display order
+	cursor       *treeEntry
+	offset       int // First visible row
+	height       int // Rows shown in the last Draw
+	icons        TreeIcons
+	searching    bool
+	search       string
+	origin       *treeEntry // Cursor when the search started
+	err          error
+	keymap       *Keymap
+	searchKeymap *Keymap
+	focused      bool
+	onSelect     func(node TreeNode)
+}
+
+// NewTree creates a tree showing the roots of a data source
+// A failure to load them is available from Err.
+func NewTree(source TreeDataSource) *Tree {
+	t := &Tree{
+		source:       source,
+		icons:        DefaultTreeIcons(),
+		keymap:       DefaultTreeKeymap(),
+		searchKeymap: DefaultTreeSearchKeymap(),
+	}
+	t.Reload()
+	return t
+}
+
+// DefaultTreeKeymap returns the default key bindings of a Tree
+func DefaultTreeKeymap() *Keymap {
+	return NewKeymap("tree",
+		KeyBinding{Action: "up", Keys: []string{"up", "k"}, Help: "up"},
+		KeyBinding{Action: "down", Keys: []string{"down", "j"}, Help: "down"},
+		KeyBinding{Action: "collapse", Keys: []string{"left", "h"}, Help: "collapse/parent"},
+		KeyBinding{Action: "expand", Keys: []string{"right", "l"}, Help: "expand/child"},
+		KeyBinding{Action: "page-up", Keys: []string{"pgup"}, Help: "page up"},
+		KeyBinding{Action: "page-down", Keys: []string{"pgdown"}, Help: "page down"},
+		KeyBinding{Action: "top", Keys: []string{"home", "g"}, Help: "first"},
+		KeyBinding{Action: "bottom", Keys: []string{"end", "G"}, Help: "last"},
+		KeyBinding{Action: "toggle", Keys: []string{" "}, Help: "expand/collapse"},
+		KeyBinding{Action: "select", Keys: []string{"enter"}, Help: "open"},
+		KeyBinding{Action: "search", Keys: []string{"/"}, Help: "search"},
+		KeyBinding{Action: "next-match", Keys: []string{"n"}, Help: "next match"},
+		KeyBinding{Action: "previous-match", Keys: []string{"N"}, Help: "previous match"},
+	)
+}
+
+// DefaultTreeSearchKeymap returns the key bindings used while typing a
+// search
+// Other printable keys are added to the search text.
+func DefaultTreeSearchKeymap() *Keymap {
+	return NewKeymap("tree-search",
+		KeyBinding{Action: "accept", Keys: []string{"enter"}, Help: "accept"},
+		KeyBinding{Action: "cancel", Keys: []string{"esc"}, Help: "cancel"},
+		KeyBinding{Action: "delete-back", Keys: []string{"backspace", "ctrl+h"}, Help: "delete back"},
+		KeyBinding{Action: "next-match", Keys: []string{"down", "ctrl+n"}, Help: "next match"},
+		KeyBinding{Action: "previous-match", Keys: []string{"up", "ctrl+p"}, Help: "previous match"},
+	)
+}
+
+// Keymap returns the tree's key bindings
+func (t *Tree) Keymap() *Keymap {
+	return t.keymap
+}
+
+// SetKeymap sets the tree's key bindings
+func (t *Tree) SetKeymap(keymap *Keymap) {
+	t.keymap = keymap
+}
+
+// SearchKeymap returns the key bindings used while typing a search
+func (t *Tree) SearchKeymap() *Keymap {
+	return t.searchKeymap
+}
+
+// SetSearchKeymap sets the key bindings used while typing a search
+func (t *Tree) SetSearchKeymap(keymap *Keymap) {
+	t.searchKeymap = keymap
+}
+
+// SetIcons sets the markers drawn in front of nodes
+func (t *Tree) SetIcons(icons TreeIcons) {
+	t.icons = icons
+}
+
+// SetSource replaces the data source and reloads the tree
+func (t *Tree) SetSource(source TreeDataSource) error {
+	t.source = source
+	t.roots = nil
+	return t.Reload()
+}
+
+// OnSelect sets the function called when enter is pressed on a node
+func (t *Tree) OnSelect(handler func(node TreeNode)) {
+	t.onSelect = handler
+}
+
+// Err returns the last error from the data source, or nil
+func (t *Tree) Err() error {
+	return t.err
+}
+
+// Reload fetches the nodes again, keeping expanded nodes expanded and the
+// cursor on the same node when they still exist
+func (t *Tree) Reload() error {
+	expanded := map[string]bool{}
+	var walk func(entries []*treeEntry)
+	walk = func(entries []*treeEntry) {
+		for _, e := range entries {
+			if e.expanded {
+				expanded[e.node.ID] = true
+			}
+			walk(e.children)
+		}
+	}
+	walk(t.roots)
+	cursorID := ""
+	if t.cursor != nil {
+		cursorID = t.cursor.node.ID
+	}
+
+	t.err = nil
+	nodes, err := t.source.Roots()
+	if err != nil {
+		t.err = err
+		return err
+	}
+	t.roots = t.entries(nodes, nil)
+
+	var restore func(entries []*treeEntry)
+	restore = func(entries []*treeEntry) {
+		for _, e := range entries {
+			if expanded[e.node.ID] && t.expand(e) == nil {
+				restore(e.children)
+			}
+		}
+	}
+	restore(t.roots)
+
+	t.cursor = nil
+	t.rebuild()
+	if !t.SetCursor(cursorID) && len(t.rows) > 0 {
+		t.cursor = t.rows[0]
+	}
+	return t.err
+}
+
+// entries wraps nodes for a parent
+func (t *Tree) entries(nodes []TreeNode, parent *treeEntry) []*treeEntry {
+	entries := make([]*treeEntry, len(nodes))
+	for i, node := range nodes {
+		entries[i] = &treeEntry{node: node, parent: parent, last: i == len(nodes)-1}
+	}
+	return entries
+}
+
+// expand loads an entry's children if needed and expands it
+func (t *Tree) expand(e *treeEntry) error {
+	if e.node.Leaf {
+		return nil
+	}
+	if !e.loaded {
+		nodes, err := t.source.Children(e.node)
+		if err != nil {
+			t.err = err
+			return err
+		}
+		e.children = t.entries(nodes, e)
+		e.loaded = true
+	}
+	e.expanded = true
+	return nil
+}
+
+// rebuild recomputes the visible rows
+func (t *Tree) rebuild() {
+	t.rows = t.rows[:0]
+	var add func(entries []*treeEntry)
+	add = func(entries []*treeEntry) {
+		for _, e := range entries {
+			t.rows = append(t.rows, e)
+			if e.expanded {
+				add(e.children)
+			}
+		}
+	}
+	add(t.roots)
+}
+
+// find returns the visible entry with an ID
+func (t *Tree) find(id string) *treeEntry {
+	for _, e := range t.rows {
+		if e.node.ID == id {
+			return e
+		}
+	}
+	return nil
+}
+
+// rowOf returns the visible row of an entry, or -1
+func (t *Tree) rowOf(e *treeEntry) int {
+	for row, r := range t.rows {
+		if r == e {
+			return row
+		}
+	}
+	return -1
+}
+
+// Visible returns the nodes currently shown, in display order
+func (t *Tree) Visible() []TreeNode {
+	nodes := make([]TreeNode, len(t.rows))
+	for i, e := range t.rows {
+		nodes[i] = e.node
+	}
+	return nodes
+}
+
+// Cursor returns the node under the cursor
+func (t *Tree) Cursor() (TreeNode, bool) {
+	if t.cursor == nil {
+		return TreeNode{}, false
+	}
+	return t.cursor.node, true
+}
+
+// SetCursor moves the cursor to a visible node
+// Returns false if no visible node has the ID.
+func (t *Tree) SetCursor(id string) bool {
+	if e := t.find(id); e != nil {
+		t.cursor = e
+		return true
+	}
+	return false
+}
+
+// Parent returns the parent of a visible node
+func (t *Tree) Parent(id string) (TreeNode, bool) {
+	if e := t.find(id); e != nil && e.parent != nil {
+		return e.parent.node, true
+	}
+	return TreeNode{}, false
+}
+
+// IsExpanded returns whether a visible node is expanded
+func (t *Tree) IsExpanded(id string) bool {
+	e := t.find(id)
+	return e != nil && e.expanded
+}
+
+// Expand expands a visible node, loading its children the first time
+func (t *Tree) Expand(id string) error {
+	e := t.find(id)
+	if e == nil {
+		return nil
+	}
+	err := t.expand(e)
+	t.rebuild()
+	return err
+}
+
+// Collapse collapses a visible node
+// The cursor moves to the node if it was on one of its descendants.
+func (t *Tree) Collapse(id string) {
+	e := t.find(id)
+	if e == nil {
+		return
+	}
+	e.expanded = false
+	for p := t.cursor; p != nil; p = p.parent {
+		if p == e {
+			t.cursor = e
+			break
+		}
+	}
+	t.rebuild()
+}
+
+// Toggle expands a collapsed node or collapses an expanded one
+func (t *Tree) Toggle(id string) error {
+	if t.IsExpanded(id) {
+		t.Collapse(id)
+		return nil
+	}
+	return t.Expand(id)
+}
+
+// move moves the cursor by delta rows, stopping at the ends
+func (t *Tree) move(delta int) {
+	if len(t.rows) == 0 {
+		return
+	}
+	row := max(0, min(t.rowOf(t.cursor)+delta, len(t.rows)-1))
+	t.cursor = t.rows[row]
+}
+
+// Searching returns whether a search is being typed
+func (t *Tree) Searching() bool {
+	return t.searching
+}
+
+// Search returns the current or last search text
+func (t *Tree) Search() string {
+	return t.search
+}
+
+// findMatch moves the cursor to the next visible node whose title contains
+// the search text, ignoring case, starting at the given row offset from the
+// cursor and wrapping around
+// Returns false if no node matches.
+func (t *Tree) findMatch(start, direction int) bool {
+	if t.search == "" || len(t.rows) == 0 {
+		return false
+	}
+	row := max(0, t.rowOf(t.cursor))
+	n := len(t.rows)
+	for i := 0; i < n; i++ {
+		e := t.rows[((row+direction*(start+i))%n+n)%n]
+		if strings.Contains(strings.ToLower(e.node.Title), strings.ToLower(t.search)) {
+			t.cursor = e
+			return true
+		}
+	}
+	return false
+}
+
+// handleSearchKey processes a key while a search is being typed
+func (t *Tree) handleSearchKey(key string) bool {
+	switch t.searchKeymap.Action(key) {
+	case "accept":
+		t.searching = false
+	case "cancel":
+		t.searching = false
+		t.search = ""
+		t.cursor = t.origin
+	case "delete-back":
+		if t.search == "" {
+			t.searching = false
+			return true
+		}
+		_, size := utf8.DecodeLastRuneInString(t.search)
+		t.search = t.search[:len(t.search)-size]
+		t.cursor = t.origin
+		t.findMatch(0, 1)
+	case "next-match":
+		t.findMatch(1, 1)
+	case "previous-match":
+		t.findMatch(1, -1)
+	default:
+		if len([]rune(key)) != 1 || key[0] < 32 {
+			return true // Swallow other keys while searching
+		}
+		t.search += key
+		// Search from where it started so narrowing stays on the first match
+		cursor := t.cursor
+		t.cursor = t.origin
+		if !t.findMatch(0, 1) {
+			t.cursor = cursor
+		}
+	}
+	return true
+}
+
+// HandleKey processes keyboard input
+// Returns true if the key was handled
+func (t *Tree) HandleKey(key string) bool {
+	if t.searching {
+		return t.handleSearchKey(key)
+	}
+
+	switch t.keymap.Action(key) {
+	case "up":
+		t.move(-1)
+	case "down":
+		t.move(1)
+	case "page-up":
+		t.move(-max(1, t.height-1))
+	case "page-down":
+		t.move(max(1, t.height-1))
+	case "top":
+		t.move(-len(t.rows))
+	case "bottom":
+		t.move(len(t.rows))
+	case "collapse":
+		if t.cursor == nil {
+			return false
+		}
+		if t.cursor.expanded {
+			t.Collapse(t.cursor.node.ID)
+		} else if t.cursor.parent != nil {
+			t.cursor = t.cursor.parent
+		}
+	case "expand":
+		if t.cursor == nil || t.cursor.node.Leaf {
+			return t.cursor != nil
+		}
+		if !t.cursor.expanded {
+			t.Expand(t.cursor.node.ID)
+		} else if len(t.cursor.children) > 0 {
+			t.cursor = t.cursor.children[0]
+		}
+	case "toggle":
+		if t.cursor == nil {
+			return false
+		}
+		t.Toggle(t.cursor.node.ID)
+	case "select":
+		if t.cursor == nil {
+			return false
+		}
+		if t.onSelect != nil {
+			t.onSelect(t.cursor.node)
+		} else {
+			t.Toggle(t.cursor.node.ID)
+		}
+	case "search":
+		t.searching = true
+		t.search = ""
+		t.origin = t.cursor
+	case "next-match":
+		return t.findMatch(1, 1)
+	case "previous-match":
+		return t.findMatch(1, -1)
+	default:
+		return false
+	}
+	return true
+}
+
+// HandleInput processes keyboard input
+func (t *Tree) HandleInput(key string) {
+	t.HandleKey(key)
+}
+
+// Focus gives keyboard focus to this component
+func (t *Tree) Focus() {
+	t.focused = true
+}
+
+// Blur removes keyboard focus from this component
+func (t *Tree) Blur() {
+	t.focused = false
+}
+
+// IsFocused returns whether this component currently has focus
+func (t *Tree) IsFocused() bool {
+	return t.focused
+}
+
+// prefix returns the guide lines and marker drawn before an entry's icon
+func (t *Tree) prefix(e *treeEntry) string {
+	var guides []string
+	for p := e.parent; p != nil && p.parent != nil; p = p.parent {
+		if p.last {
+			guides = append(guides, "  ")
+		} else {
+			guides = append(guides, "│ ")
+		}
+	}
+	// Ancestors were collected innermost first
+	for i, j := 0, len(guides)-1; i < j; i, j = i+1, j-1 {
+		guides[i], guides[j] = guides[j], guides[i]
+	}
+	if e.parent != nil {
+		if e.last {
+			guides = append(guides, "└─")
+		} else {
+			guides = append(guides, "├─")
+		}
+	}
+
+	marker := t.icons.Leaf
+	switch {
+	case e.node.Leaf:
+	case e.expanded:
+		marker = t.icons.Expanded
+	default:
+		marker = t.icons.Collapsed
+	}
+	return strings.Join(guides, "") + marker
+}
+
+// label returns the icon and title of a node
+func (t *Tree) label(node TreeNode) string {
+	if node.Icon != "" {
+		return node.Icon + " " + node.Title
+	}
+	return node.Title
+}
+
+// Measure returns the width of the widest visible row and one row per
+// visible node, plus a row for the search while one is typed
+func (t *Tree) Measure(maxWidth, maxHeight int) (width, height int) {
+	for _, e := range t.rows {
+		width = max(width, StringWidth(t.prefix(e))+StringWidth(t.label(e.node)))
+	}
+	height = max(1, len(t.rows))
+	if t.searching {
+		height++
+	}
+	return min(width, maxWidth), min(height, maxHeight)
+}
+
+// Draw renders the visible nodes, a scrollbar when they overflow and the
+// search being typed
+func (t *Tree) Draw(screen *Screen, x, y, availableWidth, availableHeight int, theme *Theme) {
+	width, height := availableWidth, availableHeight
+	if width <= 0 || height <= 0 {
+		return
+	}
+	normal := theme.Components.Interactive.Normal
+	baseStyle := lipgloss.NewStyle().Foreground(normal.Text).Background(normal.Background)
+	ClearArea(screen, x, y, width, height, baseStyle)
+
+	if t.searching && height > 1 {
+		height--
+		screen.DrawString(x, y+height, Truncate("/"+t.search, width), baseStyle)
+	}
+	t.height = height
+
+	if len(t.rows) == 0 {
+		emptyStyle := baseStyle.Foreground(theme.Palette.TextMuted).Italic(true)
+		screen.DrawString(x, y, Truncate("Empty", width), emptyStyle)
+		return
+	}
+
+	t.scrollIntoView()
+	scrollbar := len(t.rows) > height && width > 1
+	if scrollbar {
+		width--
+	}
+
+	guideStyle := baseStyle.Foreground(theme.Palette.Border)
+	for i := 0; i < height && t.offset+i < len(t.rows); i++ {
+		e := t.rows[t.offset+i]
+		rowY := y + i
+
+		style := baseStyle
+		if e == t.cursor {
+			colors := theme.Components.Interactive.Hover
+			if t.focused {
+				colors = theme.Components.Interactive.Selected
+			}
+			style = lipgloss.NewStyle().Foreground(colors.Text).Background(colors.Background).Bold(t.focused)
+		}
+
+		prefix := t.prefix(e)
+		screen.DrawString(x, rowY, Truncate(prefix, width), guideStyle)
+		col := x + StringWidth(prefix)
+		if col >= x+width {
+			continue
+		}
+		label := Truncate(t.label(e.node), x+width-col)
+		screen.DrawString(col, rowY, FillRight(label, x+width-col), style)
+
+		// Highlight the search text in matching titles
+		if t.search != "" {
+			t.drawMatch(screen, e.node, col, rowY, x+width, style.Foreground(theme.Palette.Gold).Bold(true))
+		}
+	}
+
+	if scrollbar {
+		drawVerticalScrollbar(screen, x+width, y, height, len(t.rows), t.offset, t.focused, theme)
+	}
+}
+
+// drawMatch redraws the part of a node's title that matches the search
+func (t *Tree) drawMatch(screen *Screen, node TreeNode, col, y, limit int, style lipgloss.Style) {
+	title := []rune(node.Title)
+	lower := []rune(strings.ToLower(node.Title))
+	search := []rune(strings.ToLower(t.search))
+	if len(lower) != len(title) {
+		return // Lowercasing changed the length; skip highlighting
+	}
+	start := strings.Index(string(lower), string(search))
+	if start < 0 {
+		return
+	}
+	start = utf8.RuneCountInString(string(lower)[:start])
+	if t.label(node) != node.Title {
+		col += StringWidth(node.Icon) + 1
+	}
+	col += StringWidth(string(title[:start]))
+	for _, r := range title[start : start+len(search)] {
+		w := RuneWidth(r)
+		if col+w > limit {
+			return
+		}
+		screen.DrawRune(col, y, r, style)
+		col += w
+	}
+}
+
+// scrollIntoView scrolls the least needed to show the cursor
+func (t *Tree) scrollIntoView() {
+	t.offset = max(0, min(t.offset, len(t.rows)-t.height))
+	row := t.rowOf(t.cursor)
+	if row < 0 {
+		return
+	}
+	if row < t.offset {
+		t.offset = row
+	} else if row >= t.offset+t.height {
+		t.offset = row - t.height + 1
+	}
+}
@@ -0,0 +1,200 @@
+package tui
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// countingSource records which nodes had their children loaded
+type countingSource struct {
+	*StaticTreeSource
+	loaded []string
+}
+
+func (s *countingSource) Children(node TreeNode) ([]TreeNode, error) {
+	s.loaded = append(s.loaded, node.ID)
+	return s.StaticTreeSource.Children(node)
+}
+
+func newTestTree() (*Tree, *countingSource) {
+	static := NewStaticTreeSource()
+	static.Add("", TreeNode{ID: "src", Title: "src"}, TreeNode{ID: "docs", Title: "docs"}, TreeNode{ID: "go.mod", Title: "go.mod", Leaf: true})
+	static.Add("src", TreeNode{ID: "src/ui", Title: "ui"}, TreeNode{ID: "src/main.go", Title: "main.go", Leaf: true})
+	static.Add("src/ui", TreeNode{ID: "src/ui/tree.go", Title: "tree.go", Leaf: true})
+	static.Add("docs", TreeNode{ID: "docs/README.md", Title: "README.md", Leaf: true})
+	source := &countingSource{StaticTreeSource: static}
+	tree := NewTree(source)
+	tree.Focus()
+	return tree, source
+}
+
+func visibleIDs(tree *Tree) []string {
+	var ids []string
+	for _, node := range tree.Visible() {
+		ids = append(ids, node.ID)
+	}
+	return ids
+}
+
+func cursorID(tree *Tree) string {
+	node, _ := tree.Cursor()
+	return node.ID
+}
+
+func TestTreeLoadsChildrenLazily(t *testing.T) {
+	tree, source := newTestTree()
+	if len(source.loaded) != 0 {
+		t.Fatalf("Expected no children loaded up front, got %v", source.loaded)
+	}
+
+	tree.HandleKey("right")
+	if want := []string{"src", "src/ui", "src/main.go", "docs", "go.mod"}; !reflect.DeepEqual(visibleIDs(tree), want) {
+		t.Errorf("Expected %v, got %v", want, visibleIDs(tree))
+	}
+
+	// Collapsing and expanding again doesn't reload
+	tree.HandleKey(" ")
+	tree.HandleKey(" ")
+	if !reflect.DeepEqual(source.loaded, []string{"src"}) {
+		t.Errorf("Expected src to be loaded once, got %v", source.loaded)
+	}
+}
+
+func TestTreeLeftAndRightNavigation(t *testing.T) {
+	tree, _ := newTestTree()
+
+	tree.HandleKey("right") // Expands src
+	tree.HandleKey("right") // Moves to its first child
+	tree.HandleKey("right") // Expands ui
+	tree.HandleKey("right") // Moves to tree.go
+	if cursorID(tree) != "src/ui/tree.go" {
+		t.Fatalf("Expected the cursor on tree.go, got %q", cursorID(tree))
+	}
+	tree.HandleKey("right") // Leaves don't expand
+	if cursorID(tree) != "src/ui/tree.go" {
+		t.Errorf("Expected the cursor to stay on the leaf, got %q", cursorID(tree))
+	}
+
+	tree.HandleKey("left") // Goes to the parent
+	if cursorID(tree) != "src/ui" {
+		t.Errorf("Expected the cursor on ui, got %q", cursorID(tree))
+	}
+	tree.HandleKey("left") // Collapses ui
+	if tree.IsExpanded("src/ui") || cursorID(tree) != "src/ui" {
+		t.Errorf("Expected ui collapsed with the cursor on it")
+	}
+
+	// Collapsing an ancestor pulls the cursor out of the hidden nodes
+	tree.Collapse("src")
+	if cursorID(tree) != "src" {
+		t.Errorf("Expected the cursor on src, got %q", cursorID(tree))
+	}
+}
+
+func TestTreeIncrementalSearch(t *testing.T) {
+	tree, _ := newTestTree()
+	tree.Expand("src")
+	tree.Expand("docs")
+
+	tree.HandleKey("/")
+	for _, key := range []string{"m", "a"} {
+		tree.HandleKey(key)
+	}
+	if cursorID(tree) != "src/main.go" {
+		t.Errorf("Expected the search to find main.go, got %q", cursorID(tree))
+	}
+	tree.HandleKey("backspace")
+	tree.HandleKey("d") // "md"
+	if cursorID(tree) != "docs/README.md" {
+		t.Errorf("Expected the search to find README.md, got %q", cursorID(tree))
+	}
+	tree.HandleKey("enter")
+	if tree.Searching() {
+		t.Error("Expected enter to end the search")
+	}
+
+	tree.HandleKey("n") // Wraps around to the only match
+	if cursorID(tree) != "docs/README.md" {
+		t.Errorf("Expected n to stay on the only match, got %q", cursorID(tree))
+	}
+
+	tree.HandleKey("/")
+	tree.HandleKey("g")
+	tree.HandleKey("esc")
+	if cursorID(tree) != "docs/README.md" {
+		t.Errorf("Expected esc to return to where the search started, got %q", cursorID(tree))
+	}
+}
+
+func TestTreeSelect(t *testing.T) {
+	var selected []string
+	tree, _ := newTestTree()
+	tree.OnSelect(func(node TreeNode) { selected = append(selected, node.ID) })
+
+	tree.HandleKey("end")
+	tree.HandleKey("enter")
+	if !reflect.DeepEqual(selected, []string{"go.mod"}) {
+		t.Errorf("Expected go.mod selected, got %v", selected)
+	}
+}
+
+func TestTreeReloadKeepsState(t *testing.T) {
+	tree, source := newTestTree()
+	tree.Expand("src")
+	tree.SetCursor("src/main.go")
+
+	source.Add("src", TreeNode{ID: "src/util.go", Title: "util.go", Leaf: true})
+	if err := tree.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if !tree.IsExpanded("src") || cursorID(tree) != "src/main.go" {
+		t.Errorf("Expected src expanded with the cursor kept, got %v at %q", visibleIDs(tree), cursorID(tree))
+	}
+	if len(tree.Visible()) != 6 {
+		t.Errorf("Expected the new file to appear, got %v", visibleIDs(tree))
+	}
+}
+
+// failingSource fails to load children
+type failingSource struct{ StaticTreeSource }
+
+func (s *failingSource) Children(node TreeNode) ([]TreeNode, error) {
+	return nil, errors.New("permission denied")
+}
+
+func TestTreeChildrenError(t *testing.T) {
+	source := &failingSource{*NewStaticTreeSource()}
+	source.Add("", TreeNode{ID: "locked", Title: "locked"})
+	tree := NewTree(source)
+
+	if err := tree.Expand("locked"); err == nil || tree.Err() == nil {
+		t.Error("Expected the error to be returned and kept")
+	}
+	if tree.IsExpanded("locked") {
+		t.Error("Expected the node to stay collapsed")
+	}
+}
+
+func TestTreeDrawsGuideLines(t *testing.T) {
+	tree, _ := newTestTree()
+	tree.Expand("src")
+	tree.Expand("src/ui")
+
+	sim := NewScreenSimulation(30, 8)
+	tree.Draw(sim.Screen, 0, 0, 30, 8, NewTestTheme())
+
+	want := []string{
+		"▾ src",
+		"├─▾ ui",
+		"│ └─  tree.go",
+		"└─  main.go",
+		"▸ docs",
+		"  go.mod",
+	}
+	for y, line := range want {
+		if got := sim.GetLine(y); got[:len(line)] != line {
+			t.Errorf("Line %d: expected %q, got %q", y, line, got)
+		}
+	}
+}
@@ -14,6 +14,7 @@ type Viewer struct {
 	height       int
 	focused      bool
 	wrapText     bool
+	keymap       *Keymap
 }
 
 // NewViewer creates a new viewer
@@ -26,9 +27,32 @@ func NewViewer() *Viewer {
 		height:       10,
 		focused:      false,
 		wrapText:     true,
+		keymap:       DefaultViewerKeymap(),
 	}
 }
 
+// DefaultViewerKeymap returns the default key bindings of a Viewer
+func DefaultViewerKeymap() *Keymap {
+	return NewKeymap("viewer",
+		KeyBinding{Action: "up", Keys: []string{"up", "k"}, Help: "scroll up"},
+		KeyBinding{Action: "down", Keys: []string{"down", "j"}, Help: "scroll down"},
+		KeyBinding{Action: "page-up", Keys: []string{"pgup"}, Help: "page up"},
+		KeyBinding{Action: "page-down", Keys: []string{"pgdown"}, Help: "page down"},
+		KeyBinding{Action: "top", Keys: []string{"home", "ctrl+home"}, Help: "top"},
+		KeyBinding{Action: "bottom", Keys: []string{"end", "ctrl+end"}, Help: "bottom"},
+	)
+}
+
+// Keymap returns the viewer's key bindings
+func (v *Viewer) Keymap() *Keymap {
+	return v.keymap
+}
+
+// SetKeymap sets the viewer's key bindings
+func (v *Viewer) SetKeymap(keymap *Keymap) {
+	v.keymap = keymap
+}
+
 // SetSize sets the display dimensions
 func (v *Viewer) SetSize(width, height int) {
 	v.width = width
@@ -93,12 +117,12 @@ func (v *Viewer) processContent() {
 
 // HandleInput processes keyboard input
 func (v *Viewer) HandleInput(key string) {
-	switch key {
-	case "up", "k":
+	switch v.keymap.Action(key) {
+	case "up":
 		if v.scrollOffset > 0 {
 			v.scrollOffset--
 		}
-	case "down", "j":
+	case "down":
 		maxScroll := len(v.lines) - v.height
 		if maxScroll < 0 {
 			maxScroll = 0
@@ -106,12 +130,12 @@ func (v *Viewer) HandleInput(key string) {
 		if v.scrollOffset < maxScroll {
 			v.scrollOffset++
 		}
-	case "pgup":
+	case "page-up":
 		v.scrollOffset -= v.height - 1
 		if v.scrollOffset < 0 {
 			v.scrollOffset = 0
 		}
-	case "pgdown":
+	case "page-down":
 		v.scrollOffset += v.height - 1
 		maxScroll := len(v.lines) - v.height
 		if maxScroll < 0 {
@@ -120,9 +144,9 @@ func (v *Viewer) HandleInput(key string) {
 		if v.scrollOffset > maxScroll {
 			v.scrollOffset = maxScroll
 		}
-	case "home", "ctrl+home":
+	case "top":
 		v.scrollOffset = 0
-	case "end", "ctrl+end":
+	case "bottom":
 		v.scrollOffset = len(v.lines) - v.height
 		if v.scrollOffset < 0 {
 			v.scrollOffset = 0
@@ -233,6 +257,24 @@ func (v *Viewer) DrawInBox(screen *Screen, x, y, width, height int, theme *Theme
 	v.Draw(screen, x+2, y+1, width-4, height-2, theme)
 }
 
+// Measure returns the size of the content when wrapped to the given width
+func (v *Viewer) Measure(maxWidth, maxHeight int) (width, height int) {
+	if v.content == "" {
+		return 0, 0
+	}
+	for _, line := range strings.Split(v.content, "\n") {
+		lines := []string{line}
+		if v.wrapText && StringWidth(line) > maxWidth && maxWidth > 0 {
+			lines = Wrap(line, maxWidth)
+		}
+		for _, wrapped := range lines {
+			width = max(width, StringWidth(wrapped))
+		}
+		height += len(lines)
+	}
+	return width, height
+}
+
 // GetLineCount returns the total number of lines
 func (v *Viewer) GetLineCount() int {
 	return len(v.lines)